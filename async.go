@@ -0,0 +1,293 @@
+// v0.5.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel/invoke"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/errors/status"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DefaultCommitTimeout is the duration after which a SubmitHandle stops waiting
+// for the commit of its transaction.
+const DefaultCommitTimeout = 5 * time.Minute
+
+// TxStatus is the outcome of the commit of a transaction.
+type TxStatus struct {
+	TxID           string `json:"txId"`
+	BlockNumber    uint64 `json:"blockNumber"`
+	ValidationCode string `json:"validationCode"`
+	Valid          bool   `json:"valid"`
+}
+
+// SubmitHandle follows a transaction submitted by SubmitAsync.  It is safe for
+// concurrent use.
+type SubmitHandle struct {
+	// TxID is the ID of the transaction.
+	TxID string
+	// Payload is the result of the endorsement of the transaction.
+	Payload []byte
+
+	done   chan struct{}
+	status *TxStatus
+	err    error
+}
+
+// Status waits for the commit of the transaction and returns its outcome.  The
+// error is non-nil if the transaction is invalid, as for Invoke, or if the
+// commit event was not received.  The status of an invalid transaction is
+// nevertheless returned.  Canceling `ctx` stops waiting but not the watch of the
+// transaction; Status can be called again.
+func (h *SubmitHandle) Status(ctx context.Context) (*TxStatus, error) {
+	select {
+	case <-h.done:
+		return h.status, h.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Done returns a channel closed when the outcome of the transaction is known.
+func (h *SubmitHandle) Done() <-chan struct{} {
+	return h.done
+}
+
+// watchCommit returns a handle waiting in the background for the status event of
+// transaction `txID` on `notifier`.  `release` is called once the event is
+// received or the wait is abandoned, unless the Client closes (`closing`).
+func watchCommit(txID string, payload []byte, notifier <-chan *fab.TxStatusEvent, release func(),
+	timeout time.Duration, closing <-chan struct{}) *SubmitHandle {
+	h := &SubmitHandle{TxID: txID, Payload: payload, done: make(chan struct{})}
+	go func() {
+		defer close(h.done)
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		select {
+		case ev := <-notifier:
+			release()
+			h.status = &TxStatus{
+				TxID:           txID,
+				BlockNumber:    ev.BlockNumber,
+				ValidationCode: ev.TxValidationCode.String(),
+				Valid:          ev.TxValidationCode == peer.TxValidationCode_VALID,
+			}
+			if !h.status.Valid {
+				h.err = status.New(status.EventServerStatus, int32(ev.TxValidationCode), "received invalid transaction", nil)
+			}
+		case <-timer.C:
+			release()
+			h.err = status.New(status.ClientStatus, status.Timeout.ToInt32(), "did not receive the commit event of "+txID, nil)
+		case <-closing:
+			// the event service is closed with the Client.
+			h.err = ErrClientClosed
+		}
+		if h.err != nil {
+			Logr.Warnf("transaction %s: %v", txID, h.err)
+		}
+	}()
+	return h
+}
+
+// SubmitAsync submits the transaction `fn` with the arguments `args` and returns
+// as soon as the orderer accepted it, without waiting for the commit.  The
+// returned handle reports the commit.  It allows to pipeline many transactions.
+func (c *Client) SubmitAsync(fn string, args ...string) (*SubmitHandle, error) {
+	return c.SubmitAsyncContext(context.Background(), fn, args...)
+}
+
+// SubmitAsyncContext is SubmitAsync with a context used as by InvokeContext.
+// The span waiting for the commit is a child of the trace context of `ctx`.
+func (c *Client) SubmitAsyncContext(ctx context.Context, fn string, args ...string) (*SubmitHandle, error) {
+	if !c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	return c.submitAsync(ctx, c.mainContract(), fn, args...)
+}
+
+// SubmitAsync submits the transaction `fn` with the arguments `args` on the
+// contract without waiting for the commit.  See Client.SubmitAsync.
+func (ct *Contract) SubmitAsync(fn string, args ...string) (*SubmitHandle, error) {
+	return ct.SubmitAsyncContext(context.Background(), fn, args...)
+}
+
+// SubmitAsyncContext is the Contract version of Client.SubmitAsyncContext.
+func (ct *Contract) SubmitAsyncContext(ctx context.Context, fn string, args ...string) (*SubmitHandle, error) {
+	if !ct.c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	return ct.c.submitAsync(ctx, ct, fn, args...)
+}
+
+// submitAsync sends the transaction `fn` of `ct` to the orderer.  It uses the
+// SDK instance of the wallet identity as the gateway API does not offer
+// asynchronous submissions.
+func (c *Client) submitAsync(ctx context.Context, ct *Contract, fn string, args ...string) (h *SubmitHandle, err error) {
+	if err := c.begin(); err != nil {
+		return nil, err
+	}
+	defer c.end()
+	if err := checkFunction(ct.contract, fn); err != nil {
+		return nil, err
+	}
+	entry := c.callLog(ct, fn)
+	entry.WithField("args", RedactArgs(fn, args)).Debug("submit async")
+	ctx, span := c.startSpan(ctx, "fabric.submit_async", ct, fn)
+	start := time.Now()
+	defer func() {
+		c.metrics.call("submit_async", fn, time.Since(start), err)
+		endSpan(span, err)
+	}()
+	if err := c.submitLim.wait(ctx); err != nil {
+		return nil, err
+	}
+	_, err = c.withRetry(ctx, fn, true, c.guard(func() ([]byte, error) {
+		var err error
+		h, err = c.send(ctx, ct, fn, args)
+		return nil, err
+	}))
+	if err != nil {
+		logCall(entry, "", start, err)
+		return nil, err
+	}
+	entry.WithField(LogFieldTxID, h.TxID).Debug("transaction sent")
+	span.SetAttributes(attrTxID.String(h.TxID))
+
+	_, wait := c.startSpan(ctx, "fabric.commit_wait", ct, fn)
+	wait.SetAttributes(attrTxID.String(h.TxID))
+	go func(sent time.Time) {
+		<-h.done
+		c.metrics.phase("submit", fn, "commit", time.Since(sent))
+		logCall(entry, h.TxID, start, h.err)
+		if h.status != nil {
+			wait.SetAttributes(attrBlock.Int64(int64(h.status.BlockNumber)), attrCode.String(h.status.ValidationCode))
+		}
+		endSpan(wait, h.err)
+	}(time.Now())
+	return h, nil
+}
+
+// send endorses the transaction `fn` of `ct` and sends it to the orderer.  The
+// endorsement and the ordering are recorded as phases of the span of `ctx`.  The
+// returned handle watches the commit.
+func (c *Client) send(ctx context.Context, ct *Contract, fn string, args []string) (*SubmitHandle, error) {
+	cc, err := c.channelClient(ct.channel)
+	if err != nil {
+		return nil, err
+	}
+	commit := &asyncCommitHandler{start: time.Now()}
+	resp, err := cc.InvokeHandler(invoke.NewSelectAndEndorseHandler(
+		invoke.NewEndorsementValidationHandler(
+			invoke.NewSignatureValidationHandler(commit),
+		),
+	), c.asyncRequest(ctx, ct, fn, args))
+	if !commit.sending.IsZero() {
+		c.phaseSpan(ctx, "fabric.endorse", commit.start, commit.sending)
+		c.metrics.phase("submit", fn, "endorse", commit.sending.Sub(commit.start))
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.phaseSpan(ctx, "fabric.order", commit.sending, commit.sent)
+	c.metrics.phase("submit", fn, "order", commit.sent.Sub(commit.sending))
+	return watchCommit(string(resp.TransactionID), resp.Payload, commit.notifier, commit.release,
+		DefaultCommitTimeout, c.closingCh()), nil
+}
+
+// asyncRequest returns the request of the channel client for transaction `fn`
+// of `ct`.  The function is qualified by the bare name of the contract, as the
+// gateway does; the name of the SDK contract is prefixed by the chaincode ID.
+func (c *Client) asyncRequest(ctx context.Context, ct *Contract, fn string, args []string) channel.Request {
+	req := channel.Request{ChaincodeID: ct.chaincode, Fcn: fn, Args: make([][]byte, len(args)),
+		TransientMap: c.traceTransient(ctx)}
+	if ct.name != "" {
+		req.Fcn = ct.name + ":" + fn
+	}
+	for i, a := range args {
+		req.Args[i] = []byte(a)
+	}
+	return req
+}
+
+// phaseSpan records the span `name` of a phase between `start` and `end` as a
+// child of the span of `ctx`.
+func (c *Client) phaseSpan(ctx context.Context, name string, start time.Time, end time.Time) {
+	t := c.tracer
+	if t == nil {
+		return
+	}
+	_, span := t.Start(ctx, name, trace.WithTimestamp(start))
+	span.End(trace.WithTimestamp(end))
+}
+
+// channelClient returns the channel client of channel `channelID` for the
+// identity of the Client.
+func (c *Client) channelClient(channelID string) (*channel.Client, error) {
+	cp, err := c.channelContext(channelID)
+	if err != nil {
+		return nil, err
+	}
+	c.ledgerMu.Lock()
+	defer c.ledgerMu.Unlock()
+	if cc, ok := c.channelClients[channelID]; ok {
+		return cc, nil
+	}
+	cc, err := channel.New(cp)
+	if err != nil {
+		Logr.Errorf("could not create channel client on %s: %v", channelID, err)
+		return nil, err
+	}
+	if c.channelClients == nil {
+		c.channelClients = make(map[string]*channel.Client)
+	}
+	c.channelClients[channelID] = cc
+	return cc, nil
+}
+
+// asyncCommitHandler is the last handler of the chain of SubmitAsync.  Unlike
+// invoke.CommitTxHandler, it registers the status event of the transaction and
+// sends it to the orderer without waiting for the event.
+type asyncCommitHandler struct {
+	notifier <-chan *fab.TxStatusEvent
+	release  func()
+	// start, sending and sent time the endorsement and the ordering.
+	start, sending, sent time.Time
+}
+
+// Handle sends the endorsed transaction.
+func (a *asyncCommitHandler) Handle(rc *invoke.RequestContext, cc *invoke.ClientContext) {
+	txID := string(rc.Response.TransactionID)
+	reg, notifier, err := cc.EventService.RegisterTxStatusEvent(txID)
+	if err != nil {
+		rc.Error = errors.Wrap(err, "error registering for TxStatus event")
+		return
+	}
+	var once sync.Once
+	release := func() { once.Do(func() { cc.EventService.Unregister(reg) }) }
+
+	a.sending = time.Now()
+	tx, err := cc.Transactor.CreateTransaction(fab.TransactionRequest{
+		Proposal:          rc.Response.Proposal,
+		ProposalResponses: rc.Response.Responses,
+	})
+	if err != nil {
+		release()
+		rc.Error = errors.WithMessage(err, "CreateTransaction failed")
+		return
+	}
+	if _, err := cc.Transactor.SendTransaction(tx); err != nil {
+		release()
+		rc.Error = errors.WithMessage(err, "SendTransaction failed")
+		return
+	}
+	a.notifier, a.release, a.sent = notifier, release, time.Now()
+}
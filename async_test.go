@@ -0,0 +1,91 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_watchCommit(t *testing.T) {
+	require := require.New(t)
+
+	var released int32
+	release := func() { atomic.AddInt32(&released, 1) }
+	closing := make(chan struct{})
+
+	// valid transaction
+	notifier := make(chan *fab.TxStatusEvent, 1)
+	h := watchCommit("tx1", []byte("ok"), notifier, release, time.Minute, closing)
+	require.Equal("tx1", h.TxID)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := h.Status(ctx)
+	require.ErrorIs(err, context.DeadlineExceeded)
+	notifier <- &fab.TxStatusEvent{TxID: "tx1", TxValidationCode: peer.TxValidationCode_VALID, BlockNumber: 12}
+	st, err := h.Status(context.Background())
+	require.NoError(err)
+	require.Equal(&TxStatus{TxID: "tx1", BlockNumber: 12, ValidationCode: "VALID", Valid: true}, st)
+	require.EqualValues(1, atomic.LoadInt32(&released))
+
+	// invalid transaction
+	notifier = make(chan *fab.TxStatusEvent, 1)
+	notifier <- &fab.TxStatusEvent{TxID: "tx2", TxValidationCode: peer.TxValidationCode_MVCC_READ_CONFLICT, BlockNumber: 13}
+	h = watchCommit("tx2", nil, notifier, release, time.Minute, closing)
+	st, err = h.Status(context.Background())
+	require.Error(err)
+	require.True(IsTransient(err))
+	require.False(st.Valid)
+	require.Equal("MVCC_READ_CONFLICT", st.ValidationCode)
+
+	// no event
+	h = watchCommit("tx3", nil, make(chan *fab.TxStatusEvent), release, 10*time.Millisecond, closing)
+	<-h.Done()
+	_, err = h.Status(context.Background())
+	require.Error(err)
+	require.True(IsTransient(err))
+	require.EqualValues(3, atomic.LoadInt32(&released))
+
+	// Client closed
+	h = watchCommit("tx4", nil, make(chan *fab.TxStatusEvent), release, time.Minute, closing)
+	close(closing)
+	_, err = h.Status(context.Background())
+	require.ErrorIs(err, ErrClientClosed)
+	require.EqualValues(3, atomic.LoadInt32(&released))
+}
+
+func Test_Client_SubmitAsync_Closed(t *testing.T) {
+	c := &Client{}
+	_, err := c.SubmitAsync("createCar")
+	require.ErrorIs(t, err, ErrClientNotInitialized)
+
+	c = fakeClient(&fakeContract{})
+	closing := c.closingCh()
+	c.Close()
+	<-closing
+	_, err = c.SubmitAsync("createCar")
+	require.ErrorIs(t, err, ErrClientClosed)
+}
+
+func Test_Client_asyncRequest(t *testing.T) {
+	require := require.New(t)
+	c := fakeClient(&fakeContract{})
+
+	req := c.asyncRequest(context.Background(), c.mainContract(), "createCar", []string{"CAR1", "VW"})
+	require.Equal("fabcar", req.ChaincodeID)
+	require.Equal("createCar", req.Fcn)
+	require.Equal([][]byte{[]byte("CAR1"), []byte("VW")}, req.Args)
+
+	c = fakeClient(&fakeContract{name: "fabcar:AuditContract"})
+	c.cfg.ContractName = "AuditContract"
+	req = c.asyncRequest(context.Background(), c.mainContract(), "Record", nil)
+	require.Equal("AuditContract:Record", req.Fcn)
+}
@@ -0,0 +1,200 @@
+// v0.1.2
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Call is a transaction of a batch.
+type Call struct {
+	Fn   string
+	Args []string
+}
+
+// Receipt is the outcome of the call `Index` of a batch.
+type Receipt struct {
+	Index    int
+	Fn       string
+	Payload  []byte
+	Err      error
+	Duration time.Duration
+}
+
+type batchOptions struct {
+	parallelism int
+	rate        float64
+	stopOnError bool
+	progress    func(done int, total int, r *Receipt)
+}
+
+// BatchOption allows to parameterize the SubmitBatch function.
+type BatchOption func(opts *batchOptions)
+
+// WithParallelism submits up to `n` transactions concurrently.  The default is 8.
+func WithParallelism(n int) BatchOption {
+	return func(bo *batchOptions) {
+		bo.parallelism = n
+	}
+}
+
+// WithBatchRate starts at most `perSecond` transactions per second.  Zero, the
+// default, does not limit the rate.
+func WithBatchRate(perSecond float64) BatchOption {
+	return func(bo *batchOptions) {
+		bo.rate = perSecond
+	}
+}
+
+// WithStopOnError stops starting new transactions after the first failure.  By
+// default, the batch submits every transaction regardless of the failures.
+func WithStopOnError() BatchOption {
+	return func(bo *batchOptions) {
+		bo.stopOnError = true
+	}
+}
+
+// WithProgress calls `fn` after each transaction with the number of completed
+// transactions, the size of the batch and the receipt.  The calls of `fn` are
+// serialized.
+func WithProgress(fn func(done int, total int, r *Receipt)) BatchOption {
+	return func(bo *batchOptions) {
+		bo.progress = fn
+	}
+}
+
+// SubmitBatch submits the transactions `calls` concurrently.  It returns one
+// receipt per call, in the order of `calls`.  The transactions that were not
+// started, because of a failure in stop-on-error mode or because `ctx` was
+// canceled, have the error ErrBatchAborted, respectively the error of `ctx`.
+// The error is the first failure in stop-on-error mode, the error of `ctx` if
+// canceled, or else ErrBatchFailed if some transactions failed.
+func (c *Client) SubmitBatch(ctx context.Context, calls []Call, opts ...BatchOption) ([]Receipt, error) {
+	if !c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	return c.submitBatch(ctx, c.mainContract(), calls, opts)
+}
+
+// SubmitBatch submits the transactions `calls` concurrently on the contract.
+// See Client.SubmitBatch.
+func (ct *Contract) SubmitBatch(ctx context.Context, calls []Call, opts ...BatchOption) ([]Receipt, error) {
+	if !ct.c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	return ct.c.submitBatch(ctx, ct, calls, opts)
+}
+
+func (c *Client) submitBatch(ctx context.Context, ct *Contract, calls []Call, opts []BatchOption) ([]Receipt, error) {
+	bo := batchOptions{parallelism: 8}
+	for _, opt := range opts {
+		opt(&bo)
+	}
+	if bo.parallelism < 1 {
+		bo.parallelism = 1
+	}
+	// canceling the dispatch does not cancel the started transactions, that may
+	// commit.
+	dispatch, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	receipts := make([]Receipt, len(calls))
+	var (
+		mu       sync.Mutex
+		done     int
+		failed   int
+		firstErr error
+	)
+	finish := func(r *Receipt) {
+		mu.Lock()
+		defer mu.Unlock()
+		done++
+		if r.Err != nil {
+			failed++
+			if firstErr == nil {
+				firstErr = r.Err
+				if bo.stopOnError {
+					cancel()
+				}
+			}
+		}
+		if bo.progress != nil {
+			bo.progress(done, len(calls), r)
+		}
+	}
+
+	var tick <-chan time.Time
+	if bo.rate > 0 {
+		t := time.NewTicker(time.Duration(float64(time.Second) / bo.rate))
+		defer t.Stop()
+		tick = t.C
+	}
+
+	sem := make(chan struct{}, bo.parallelism)
+	var wg sync.WaitGroup
+	started := 0
+	for i := range calls {
+		receipts[i] = Receipt{Index: i, Fn: calls[i].Fn}
+		if (tick == nil || i == 0 || wait(dispatch, tick)) && acquire(dispatch, sem) {
+			started++
+			wg.Add(1)
+			go func(r *Receipt, call Call) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				start := time.Now()
+				r.Payload, r.Err = c.submit(ctx, ct, call.Fn, call.Args...)
+				r.Duration = time.Since(start)
+				finish(r)
+			}(&receipts[i], calls[i])
+		}
+	}
+	wg.Wait()
+
+	aborted := ErrBatchAborted
+	if !bo.stopOnError || firstErr == nil {
+		aborted = ctx.Err()
+	}
+	for i := started; i < len(calls); i++ {
+		// the calls are started in order, thus the unstarted ones are the last.
+		receipts[i].Err = aborted
+		finish(&receipts[i])
+	}
+	switch {
+	case bo.stopOnError && firstErr != nil:
+		return receipts, firstErr
+	case started < len(calls):
+		return receipts, aborted
+	case failed > 0:
+		return receipts, fmt.Errorf("%w: %d of %d transactions", ErrBatchFailed, failed, len(calls))
+	}
+	return receipts, nil
+}
+
+// wait waits for the next tick.  It returns false if `ctx` is done first.
+func wait(ctx context.Context, tick <-chan time.Time) bool {
+	select {
+	case <-tick:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// acquire takes a slot of `sem`.  It returns false if `ctx` is done.
+func acquire(ctx context.Context, sem chan struct{}) bool {
+	select {
+	case sem <- struct{}{}:
+	case <-ctx.Done():
+		return false
+	}
+	if ctx.Err() != nil {
+		<-sem
+		return false
+	}
+	return true
+}
@@ -0,0 +1,139 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// batchContract fails the calls whose first argument starts with "bad".
+type batchContract struct {
+	fakeContract
+	max int32
+}
+
+func (f *batchContract) SubmitTransaction(name string, args ...string) ([]byte, error) {
+	n := atomic.AddInt32(&f.inflight, 1)
+	defer atomic.AddInt32(&f.inflight, -1)
+	for {
+		m := atomic.LoadInt32(&f.max)
+		if n <= m || atomic.CompareAndSwapInt32(&f.max, m, n) {
+			break
+		}
+	}
+	atomic.AddInt32(&f.calls, 1)
+	time.Sleep(f.delay)
+	if strings.HasPrefix(args[0], "bad") {
+		return nil, errors.New("car " + args[0] + " is invalid")
+	}
+	return []byte(args[0]), nil
+}
+
+// refusingContract refuses once the connection of the calls whose first argument
+// starts with "flaky".
+type refusingContract struct {
+	*batchContract
+	refused int32
+}
+
+func (f *refusingContract) SubmitTransaction(name string, args ...string) ([]byte, error) {
+	if strings.HasPrefix(args[0], "flaky") && atomic.CompareAndSwapInt32(&f.refused, 0, 1) {
+		return nil, errors.New("dial tcp: connection refused")
+	}
+	return f.batchContract.SubmitTransaction(name, args...)
+}
+
+func batchCalls(n int, bad ...int) []Call {
+	calls := make([]Call, n)
+	for i := range calls {
+		calls[i] = Call{Fn: "createCar", Args: []string{fmt.Sprintf("CAR%d", i)}}
+	}
+	for _, i := range bad {
+		calls[i].Args[0] = "bad" + calls[i].Args[0]
+	}
+	return calls
+}
+
+func Test_Client_SubmitBatch(t *testing.T) {
+	require := require.New(t)
+
+	f := &batchContract{fakeContract: fakeContract{delay: 5 * time.Millisecond}}
+	c := fakeClient(&f.fakeContract)
+	c.contract = f
+
+	var progress []int
+	rs, err := c.SubmitBatch(context.Background(), batchCalls(20), WithParallelism(4),
+		WithProgress(func(done int, total int, r *Receipt) {
+			require.Equal(20, total)
+			progress = append(progress, done)
+		}))
+	require.NoError(err)
+	require.Len(rs, 20)
+	for i, r := range rs {
+		require.Equal(i, r.Index)
+		require.NoError(r.Err)
+		require.Equal(fmt.Sprintf("CAR%d", i), string(r.Payload))
+	}
+	require.EqualValues(4, atomic.LoadInt32(&f.max))
+	require.Len(progress, 20)
+	require.Equal(20, progress[19])
+
+	// best effort
+	f.calls = 0
+	rs, err = c.SubmitBatch(context.Background(), batchCalls(10, 3, 7))
+	require.ErrorIs(err, ErrBatchFailed)
+	require.EqualValues(10, f.calls)
+	require.Error(rs[3].Err)
+	require.Error(rs[7].Err)
+	require.NoError(rs[9].Err)
+
+	// stop on error
+	f.calls = 0
+	rs, err = c.SubmitBatch(context.Background(), batchCalls(10, 0), WithParallelism(1), WithStopOnError())
+	require.Equal(rs[0].Err, err)
+	require.EqualValues(1, f.calls)
+	for _, r := range rs[1:] {
+		require.ErrorIs(r.Err, ErrBatchAborted)
+	}
+
+	// stop on error: the started transactions are not canceled, even while they
+	// wait to retry
+	calls := batchCalls(2, 0)
+	calls[1].Args[0] = "flakyCAR1"
+	c.contract = &refusingContract{batchContract: f}
+	c.retry = RetryPolicy{MaxAttempts: 2, InitialBackoff: 50 * time.Millisecond}
+	rs, err = c.SubmitBatch(context.Background(), calls, WithParallelism(2), WithStopOnError())
+	require.Equal(rs[0].Err, err)
+	require.NoError(rs[1].Err)
+	require.Equal("flakyCAR1", string(rs[1].Payload))
+}
+
+func Test_Client_SubmitBatch_Rate(t *testing.T) {
+	require := require.New(t)
+
+	f := &batchContract{}
+	c := fakeClient(&f.fakeContract)
+	c.contract = f
+
+	start := time.Now()
+	_, err := c.SubmitBatch(context.Background(), batchCalls(5), WithBatchRate(50))
+	require.NoError(err)
+	require.True(time.Since(start) >= 80*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	rs, err := c.SubmitBatch(ctx, batchCalls(100), WithBatchRate(50))
+	require.ErrorIs(err, context.DeadlineExceeded)
+	require.NoError(rs[0].Err)
+	require.ErrorIs(rs[99].Err, context.DeadlineExceeded)
+}
@@ -0,0 +1,285 @@
+// v0.2.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric-protos-go/msp"
+	"github.com/hyperledger/fabric-protos-go/peer"
+)
+
+// Block is the decoded version of a Fabric block.
+type Block struct {
+	Number       uint64         `json:"number"`
+	PreviousHash string         `json:"previousHash"` // hex encoded
+	DataHash     string         `json:"dataHash"`     // hex encoded
+	Transactions []*Transaction `json:"transactions"`
+}
+
+// Transaction is the decoded version of one envelope of a block.
+type Transaction struct {
+	// Index is the position of the transaction within the block.
+	Index int `json:"index"`
+	// BlockNumber is the number of the block holding the transaction.
+	BlockNumber uint64 `json:"blockNumber"`
+	TxID        string `json:"txId"`
+	ChannelID   string `json:"channelId"`
+	// Type is the name of the header type, e.g., "ENDORSER_TRANSACTION" or "CONFIG".
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	// CreatorMSP is the MSPID of the submitter.
+	CreatorMSP string `json:"creatorMsp"`
+	// CreatorSubject is the distinguished name of the submitter's certificate.
+	CreatorSubject string   `json:"creatorSubject,omitempty"`
+	Chaincode      string   `json:"chaincode,omitempty"`
+	Function       string   `json:"function,omitempty"`
+	Args           []string `json:"args,omitempty"`
+	// RWSets holds the read/write sets per namespace.
+	RWSets []*NsRWSet        `json:"rwsets,omitempty"`
+	Events []*ChaincodeEvent `json:"events,omitempty"`
+	// ValidationCode is the name of the validation code set by the committing peer,
+	// e.g., "VALID" or "MVCC_READ_CONFLICT".
+	ValidationCode string `json:"validationCode"`
+	Valid          bool   `json:"valid"`
+}
+
+// NsRWSet is the read/write set of a transaction for the namespace `Namespace`.
+type NsRWSet struct {
+	Namespace string     `json:"namespace"`
+	Reads     []*KVRead  `json:"reads,omitempty"`
+	Writes    []*KVWrite `json:"writes,omitempty"`
+}
+
+// KVRead is a key read by a transaction with the version it read.  `Version`
+// is nil if the key did not exist.
+type KVRead struct {
+	Key     string     `json:"key"`
+	Version *KVVersion `json:"version,omitempty"`
+}
+
+// KVVersion identifies the transaction that last wrote a key.
+type KVVersion struct {
+	BlockNum uint64 `json:"blockNum"`
+	TxNum    uint64 `json:"txNum"`
+}
+
+// KVWrite is a key written or deleted by a transaction.
+type KVWrite struct {
+	Key      string `json:"key"`
+	Value    []byte `json:"value,omitempty"`
+	IsDelete bool   `json:"isDelete,omitempty"`
+}
+
+// ChaincodeEvent is an event set by a chaincode during a transaction.
+type ChaincodeEvent struct {
+	Chaincode   string `json:"chaincode"`
+	TxID        string `json:"txId"`
+	Name        string `json:"name"`
+	Payload     []byte `json:"payload,omitempty"`
+	BlockNumber uint64 `json:"blockNumber"`
+}
+
+// DecodeBlock decodes the raw Fabric block `b`.  Envelopes that are not
+// endorser transactions, e.g., config updates, only have their header populated.
+func DecodeBlock(b *common.Block) (*Block, error) {
+	if b == nil || b.Header == nil {
+		return nil, ErrInvalidBlock
+	}
+	blk := &Block{
+		Number:       b.Header.Number,
+		PreviousHash: hex.EncodeToString(b.Header.PreviousHash),
+		DataHash:     hex.EncodeToString(b.Header.DataHash),
+	}
+	if b.Data == nil {
+		return blk, nil
+	}
+	var filter []byte
+	if b.Metadata != nil && len(b.Metadata.Metadata) > int(common.BlockMetadataIndex_TRANSACTIONS_FILTER) {
+		filter = b.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER]
+	}
+	for i, data := range b.Data.Data {
+		tx, err := decodeEnvelope(data)
+		if err != nil {
+			return nil, fmt.Errorf("block %d tx %d: %w", blk.Number, i, err)
+		}
+		tx.Index = i
+		tx.BlockNumber = blk.Number
+		for _, ev := range tx.Events {
+			ev.BlockNumber = blk.Number
+		}
+		code := peer.TxValidationCode_NOT_VALIDATED
+		if i < len(filter) {
+			code = peer.TxValidationCode(filter[i])
+		}
+		tx.ValidationCode = code.String()
+		tx.Valid = code == peer.TxValidationCode_VALID
+		blk.Transactions = append(blk.Transactions, tx)
+	}
+	return blk, nil
+}
+
+// DecodeBlockBytes decodes the marshalled Fabric block `data`.
+func DecodeBlockBytes(data []byte) (*Block, error) {
+	b := &common.Block{}
+	if err := proto.Unmarshal(data, b); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBlock, err)
+	}
+	return DecodeBlock(b)
+}
+
+// decodeEnvelope decodes the marshalled envelope `data`.
+func decodeEnvelope(data []byte) (*Transaction, error) {
+	env := &common.Envelope{}
+	if err := proto.Unmarshal(data, env); err != nil {
+		return nil, fmt.Errorf("envelope: %w", err)
+	}
+	pl := &common.Payload{}
+	if err := proto.Unmarshal(env.Payload, pl); err != nil {
+		return nil, fmt.Errorf("payload: %w", err)
+	}
+	if pl.Header == nil {
+		return nil, errors.New("payload without header")
+	}
+	chdr := &common.ChannelHeader{}
+	if err := proto.Unmarshal(pl.Header.ChannelHeader, chdr); err != nil {
+		return nil, fmt.Errorf("channel header: %w", err)
+	}
+	tx := &Transaction{
+		TxID:      chdr.TxId,
+		ChannelID: chdr.ChannelId,
+		Type:      common.HeaderType(chdr.Type).String(),
+	}
+	if chdr.Timestamp != nil {
+		tx.Timestamp = time.Unix(chdr.Timestamp.Seconds, int64(chdr.Timestamp.Nanos)).UTC()
+	}
+
+	shdr := &common.SignatureHeader{}
+	if err := proto.Unmarshal(pl.Header.SignatureHeader, shdr); err != nil {
+		return nil, fmt.Errorf("signature header: %w", err)
+	}
+	tx.CreatorMSP, tx.CreatorSubject = decodeCreator(shdr.Creator)
+
+	if common.HeaderType(chdr.Type) != common.HeaderType_ENDORSER_TRANSACTION {
+		return tx, nil
+	}
+	ptx := &peer.Transaction{}
+	if err := proto.Unmarshal(pl.Data, ptx); err != nil {
+		return nil, fmt.Errorf("transaction: %w", err)
+	}
+	for _, action := range ptx.Actions {
+		if err := decodeAction(action, tx); err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+
+// decodeAction populates `tx` with the chaincode invocation, the read/write sets
+// and the events of `action`.
+func decodeAction(action *peer.TransactionAction, tx *Transaction) error {
+	ccap := &peer.ChaincodeActionPayload{}
+	if err := proto.Unmarshal(action.Payload, ccap); err != nil {
+		return fmt.Errorf("chaincode action payload: %w", err)
+	}
+
+	cpp := &peer.ChaincodeProposalPayload{}
+	if err := proto.Unmarshal(ccap.ChaincodeProposalPayload, cpp); err != nil {
+		return fmt.Errorf("chaincode proposal payload: %w", err)
+	}
+	cis := &peer.ChaincodeInvocationSpec{}
+	if err := proto.Unmarshal(cpp.Input, cis); err != nil {
+		return fmt.Errorf("chaincode invocation spec: %w", err)
+	}
+	if spec := cis.ChaincodeSpec; spec != nil {
+		if spec.ChaincodeId != nil && tx.Chaincode == "" {
+			tx.Chaincode = spec.ChaincodeId.Name
+		}
+		if spec.Input != nil && len(spec.Input.Args) > 0 && tx.Function == "" {
+			tx.Function = string(spec.Input.Args[0])
+			for _, a := range spec.Input.Args[1:] {
+				tx.Args = append(tx.Args, string(a))
+			}
+		}
+	}
+
+	if ccap.Action == nil {
+		return nil
+	}
+	prp := &peer.ProposalResponsePayload{}
+	if err := proto.Unmarshal(ccap.Action.ProposalResponsePayload, prp); err != nil {
+		return fmt.Errorf("proposal response payload: %w", err)
+	}
+	cca := &peer.ChaincodeAction{}
+	if err := proto.Unmarshal(prp.Extension, cca); err != nil {
+		return fmt.Errorf("chaincode action: %w", err)
+	}
+
+	txrw := &rwset.TxReadWriteSet{}
+	if err := proto.Unmarshal(cca.Results, txrw); err != nil {
+		return fmt.Errorf("read/write set: %w", err)
+	}
+	for _, ns := range txrw.NsRwset {
+		kv := &kvrwset.KVRWSet{}
+		if err := proto.Unmarshal(ns.Rwset, kv); err != nil {
+			return fmt.Errorf("kv read/write set %s: %w", ns.Namespace, err)
+		}
+		set := &NsRWSet{Namespace: ns.Namespace}
+		for _, r := range kv.Reads {
+			read := &KVRead{Key: r.Key}
+			if r.Version != nil {
+				read.Version = &KVVersion{BlockNum: r.Version.BlockNum, TxNum: r.Version.TxNum}
+			}
+			set.Reads = append(set.Reads, read)
+		}
+		for _, w := range kv.Writes {
+			set.Writes = append(set.Writes, &KVWrite{Key: w.Key, Value: w.Value, IsDelete: w.IsDelete})
+		}
+		tx.RWSets = append(tx.RWSets, set)
+	}
+
+	if len(cca.Events) > 0 {
+		ev := &peer.ChaincodeEvent{}
+		if err := proto.Unmarshal(cca.Events, ev); err != nil {
+			return fmt.Errorf("chaincode event: %w", err)
+		}
+		if ev.EventName != "" {
+			tx.Events = append(tx.Events, &ChaincodeEvent{
+				Chaincode: ev.ChaincodeId,
+				TxID:      ev.TxId,
+				Name:      ev.EventName,
+				Payload:   ev.Payload,
+			})
+		}
+	}
+	return nil
+}
+
+// decodeCreator returns the MSPID and the certificate subject of the serialized
+// identity `creator`.  The subject is empty if the certificate cannot be parsed.
+func decodeCreator(creator []byte) (string, string) {
+	id := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(creator, id); err != nil {
+		return "", ""
+	}
+	blk, _ := pem.Decode(id.IdBytes)
+	if blk == nil {
+		return id.Mspid, ""
+	}
+	cert, err := x509.ParseCertificate(blk.Bytes)
+	if err != nil {
+		return id.Mspid, ""
+	}
+	return id.Mspid, cert.Subject.String()
+}
@@ -0,0 +1,143 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric-protos-go/msp"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DecodeBlock(t *testing.T) {
+	cert := testCert(t)
+	env := testEnvelope(t, "tx1", cert, "createCar", "CAR1", "VW")
+	b := &common.Block{
+		Header:   &common.BlockHeader{Number: 7, PreviousHash: []byte{0xab}},
+		Data:     &common.BlockData{Data: [][]byte{env, env}},
+		Metadata: &common.BlockMetadata{Metadata: [][]byte{nil, nil, {byte(peer.TxValidationCode_VALID), byte(peer.TxValidationCode_MVCC_READ_CONFLICT)}}},
+	}
+
+	blk, err := DecodeBlock(b)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), blk.Number)
+	require.Equal(t, "ab", blk.PreviousHash)
+	require.Len(t, blk.Transactions, 2)
+
+	tx := blk.Transactions[0]
+	require.Equal(t, "tx1", tx.TxID)
+	require.Equal(t, "mychannel", tx.ChannelID)
+	require.Equal(t, "ENDORSER_TRANSACTION", tx.Type)
+	require.Equal(t, int64(1600000000), tx.Timestamp.Unix())
+	require.Equal(t, "Org1MSP", tx.CreatorMSP)
+	require.Contains(t, tx.CreatorSubject, "CN=user1")
+	require.Equal(t, "fabcar", tx.Chaincode)
+	require.Equal(t, "createCar", tx.Function)
+	require.Equal(t, []string{"CAR1", "VW"}, tx.Args)
+	require.Len(t, tx.RWSets, 1)
+	require.Equal(t, "CAR1", tx.RWSets[0].Writes[0].Key)
+	require.Equal(t, []byte(`{"make":"VW"}`), tx.RWSets[0].Writes[0].Value)
+	require.Equal(t, uint64(3), tx.RWSets[0].Reads[0].Version.BlockNum)
+	require.Len(t, tx.Events, 1)
+	require.Equal(t, "CarCreated", tx.Events[0].Name)
+	require.Equal(t, blk.Number, tx.Events[0].BlockNumber)
+	require.True(t, tx.Valid)
+	require.False(t, blk.Transactions[1].Valid)
+	require.Equal(t, "MVCC_READ_CONFLICT", blk.Transactions[1].ValidationCode)
+	require.Equal(t, 1, blk.Transactions[1].Index)
+
+	_, err = json.Marshal(blk)
+	require.NoError(t, err)
+
+	raw, err := proto.Marshal(b)
+	require.NoError(t, err)
+	blk2, err := DecodeBlockBytes(raw)
+	require.NoError(t, err)
+	require.Equal(t, blk, blk2)
+}
+
+func Test_DecodeBlock_Invalid(t *testing.T) {
+	_, err := DecodeBlock(nil)
+	require.ErrorIs(t, err, ErrInvalidBlock)
+	_, err = DecodeBlockBytes([]byte{0xff, 0xff})
+	require.ErrorIs(t, err, ErrInvalidBlock)
+}
+
+// testCert returns the PEM certificate of user1 from the test wallet.
+func testCert(t *testing.T) string {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "conf", "wallet", "user1.id"))
+	require.NoError(t, err)
+	var id struct {
+		Credentials struct {
+			Certificate string `json:"certificate"`
+		} `json:"credentials"`
+	}
+	require.NoError(t, json.Unmarshal(data, &id))
+	return id.Credentials.Certificate
+}
+
+// testEnvelope builds a marshalled endorser transaction `txID` of fabcar
+// invoking `fn` with `args`.
+func testEnvelope(t *testing.T, txID string, cert string, fn string, args ...string) []byte {
+	mustMarshal := func(m proto.Message) []byte {
+		b, err := proto.Marshal(m)
+		require.NoError(t, err)
+		return b
+	}
+	input := [][]byte{[]byte(fn)}
+	for _, a := range args {
+		input = append(input, []byte(a))
+	}
+	kv := &kvrwset.KVRWSet{
+		Reads:  []*kvrwset.KVRead{{Key: args[0], Version: &kvrwset.Version{BlockNum: 3, TxNum: 1}}},
+		Writes: []*kvrwset.KVWrite{{Key: args[0], Value: []byte(`{"make":"VW"}`)}},
+	}
+	cca := &peer.ChaincodeAction{
+		Results: mustMarshal(&rwset.TxReadWriteSet{
+			DataModel: rwset.TxReadWriteSet_KV,
+			NsRwset:   []*rwset.NsReadWriteSet{{Namespace: "fabcar", Rwset: mustMarshal(kv)}},
+		}),
+		Events: mustMarshal(&peer.ChaincodeEvent{ChaincodeId: "fabcar", TxId: txID, EventName: "CarCreated"}),
+	}
+	ccap := &peer.ChaincodeActionPayload{
+		ChaincodeProposalPayload: mustMarshal(&peer.ChaincodeProposalPayload{
+			Input: mustMarshal(&peer.ChaincodeInvocationSpec{
+				ChaincodeSpec: &peer.ChaincodeSpec{
+					ChaincodeId: &peer.ChaincodeID{Name: "fabcar"},
+					Input:       &peer.ChaincodeInput{Args: input},
+				},
+			}),
+		}),
+		Action: &peer.ChaincodeEndorsedAction{
+			ProposalResponsePayload: mustMarshal(&peer.ProposalResponsePayload{Extension: mustMarshal(cca)}),
+		},
+	}
+	pl := &common.Payload{
+		Header: &common.Header{
+			ChannelHeader: mustMarshal(&common.ChannelHeader{
+				Type:      int32(common.HeaderType_ENDORSER_TRANSACTION),
+				TxId:      txID,
+				ChannelId: "mychannel",
+				Timestamp: &timestamp.Timestamp{Seconds: 1600000000},
+			}),
+			SignatureHeader: mustMarshal(&common.SignatureHeader{
+				Creator: mustMarshal(&msp.SerializedIdentity{Mspid: "Org1MSP", IdBytes: []byte(cert)}),
+			}),
+		},
+		Data: mustMarshal(&peer.Transaction{
+			Actions: []*peer.TransactionAction{{Payload: mustMarshal(ccap)}},
+		}),
+	}
+	return mustMarshal(&common.Envelope{Payload: mustMarshal(pl)})
+}
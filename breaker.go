@@ -0,0 +1,186 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/errors/status"
+	"github.com/spf13/viper"
+)
+
+// BreakerPolicy defines the circuit breaker protecting the peers of the Client.
+// The zero value disables the circuit breaker.
+type BreakerPolicy struct {
+	// Failures is the number of consecutive unavailability failures that opens
+	// the circuit.
+	Failures int
+	// OpenTimeout is the duration during which an open circuit rejects the calls
+	// before letting a single trial call through.
+	OpenTimeout time.Duration
+}
+
+// DefaultBreakerPolicy returns a policy opening the circuit after 5 consecutive
+// failures for 30 s.
+func DefaultBreakerPolicy() BreakerPolicy {
+	return BreakerPolicy{Failures: 5, OpenTimeout: 30 * time.Second}
+}
+
+// loadBreakerPolicy reads the section [breaker] of the configuration file.  It
+// returns the zero policy if the section is absent.
+func loadBreakerPolicy(vi *viper.Viper) BreakerPolicy {
+	if !vi.IsSet("breaker.failures") {
+		return BreakerPolicy{}
+	}
+	p := DefaultBreakerPolicy()
+	p.Failures = vi.GetInt("breaker.failures")
+	if vi.IsSet("breaker.openTimeout") {
+		p.OpenTimeout = vi.GetDuration("breaker.openTimeout")
+	}
+	return p
+}
+
+// BreakerState is the state of a circuit breaker.
+type BreakerState int
+
+// The states of a circuit breaker.
+const (
+	// BreakerClosed lets the calls through.
+	BreakerClosed BreakerState = iota
+	// BreakerOpen rejects the calls with ErrCircuitOpen.
+	BreakerOpen
+	// BreakerHalfOpen lets a single trial call through.
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// circuitBreaker counts the consecutive unavailability failures.  It is safe for
+// concurrent use.
+type circuitBreaker struct {
+	policy BreakerPolicy
+	now    func() time.Time
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	trial    bool // a trial call is in flight in half-open state.
+}
+
+func newCircuitBreaker(p BreakerPolicy) *circuitBreaker {
+	return &circuitBreaker{policy: p, now: time.Now}
+}
+
+// allow returns ErrCircuitOpen if the call must be rejected.
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == BreakerOpen && b.now().Sub(b.openedAt) >= b.policy.OpenTimeout {
+		b.state = BreakerHalfOpen
+		Logr.Info("circuit breaker half-open")
+	}
+	switch b.state {
+	case BreakerOpen:
+		return ErrCircuitOpen
+	case BreakerHalfOpen:
+		if b.trial {
+			return ErrCircuitOpen
+		}
+		b.trial = true
+	}
+	return nil
+}
+
+// record registers the outcome `err` of an allowed call.
+func (b *circuitBreaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	halfOpen := b.state == BreakerHalfOpen
+	b.trial = false
+	if !isOutage(err) {
+		if b.state != BreakerClosed {
+			Logr.Info("circuit breaker closed")
+		}
+		b.state, b.failures = BreakerClosed, 0
+		return
+	}
+	b.failures++
+	if halfOpen || b.failures >= b.policy.Failures {
+		if b.state != BreakerOpen {
+			Logr.Warnf("circuit breaker open after %d failures: %v", b.failures, err)
+		}
+		b.state, b.openedAt = BreakerOpen, b.now()
+	}
+}
+
+// State returns the current state.
+func (b *circuitBreaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == BreakerOpen && b.now().Sub(b.openedAt) >= b.policy.OpenTimeout {
+		return BreakerHalfOpen
+	}
+	return b.state
+}
+
+// isOutage returns true if `err` denotes unreachable or failing peers or
+// orderers.  Transient conflicts between transactions do not.
+func isOutage(err error) bool {
+	if !IsTransient(err) {
+		return false
+	}
+	if s, ok := status.FromError(err); ok {
+		if s.Group == status.EventServerStatus || status.Code(s.Code) == status.EndorsementMismatch {
+			return false
+		}
+	}
+	return !containsAny(err, conflictMarkers)
+}
+
+// guard returns `call` protected by the circuit breaker of the Client, if any.
+func (c *Client) guard(call func() ([]byte, error)) func() ([]byte, error) {
+	b := c.breaker
+	if b == nil {
+		return call
+	}
+	return func() ([]byte, error) {
+		if err := b.allow(); err != nil {
+			return nil, err
+		}
+		res, err := call()
+		b.record(err)
+		c.metrics.breakerState(b.State())
+		return res, err
+	}
+}
+
+// BreakerState returns the state of the circuit breaker of the Client.  It is
+// BreakerClosed if the Client has no circuit breaker.
+func (c *Client) BreakerState() BreakerState {
+	if c.breaker == nil {
+		return BreakerClosed
+	}
+	return c.breaker.State()
+}
+
+// WithCircuitBreaker sets the circuit breaker of the Client regardless of the
+// section [breaker] of the configuration file.
+func WithCircuitBreaker(policy BreakerPolicy) ClientOption {
+	return func(cp *clientOptions) {
+		cp.breaker = &policy
+	}
+}
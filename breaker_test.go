@@ -0,0 +1,79 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_circuitBreaker(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Now()
+	b := newCircuitBreaker(BreakerPolicy{Failures: 2, OpenTimeout: time.Minute})
+	b.now = func() time.Time { return now }
+	down := errors.New("connection refused")
+
+	require.NoError(b.allow())
+	b.record(down)
+	require.Equal(BreakerClosed, b.State())
+	// a conflict or a chaincode error does not count
+	require.NoError(b.allow())
+	b.record(errors.New("MVCC_READ_CONFLICT"))
+	require.Equal(BreakerClosed, b.State())
+	require.NoError(b.allow())
+	b.record(down)
+	require.NoError(b.allow())
+	b.record(down)
+	require.Equal(BreakerOpen, b.State())
+	require.ErrorIs(b.allow(), ErrCircuitOpen)
+
+	// half-open lets a single trial through
+	now = now.Add(time.Minute)
+	require.Equal(BreakerHalfOpen, b.State())
+	require.NoError(b.allow())
+	require.ErrorIs(b.allow(), ErrCircuitOpen)
+	b.record(down)
+	require.Equal(BreakerOpen, b.State())
+
+	now = now.Add(time.Minute)
+	require.NoError(b.allow())
+	b.record(nil)
+	require.Equal(BreakerClosed, b.State())
+	require.NoError(b.allow())
+}
+
+func Test_Client_CircuitBreaker(t *testing.T) {
+	require := require.New(t)
+
+	f := &fakeContract{err: errors.New("connect: connection refused")}
+	c := fakeClient(f)
+	c.breaker = newCircuitBreaker(BreakerPolicy{Failures: 3, OpenTimeout: time.Hour})
+	for i := 0; i < 3; i++ {
+		_, err := c.Query("QueryCar", "CAR1")
+		require.Equal(f.err, err)
+	}
+	require.Equal(BreakerOpen, c.BreakerState())
+	_, err := c.Invoke("createCar")
+	require.ErrorIs(err, ErrCircuitOpen)
+	require.EqualValues(3, f.calls)
+
+	// the retries stop when the circuit opens
+	f.calls = 0
+	c.breaker = newCircuitBreaker(BreakerPolicy{Failures: 2, OpenTimeout: time.Hour})
+	c.retry = RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond}
+	_, err = c.Query("QueryCar", "CAR1")
+	require.ErrorIs(err, ErrCircuitOpen)
+	require.EqualValues(2, f.calls)
+}
+
+func Test_Configuration_Load_Breaker(t *testing.T) {
+	cp := loadTestConfig(t, "[breaker]\nfailures = 3\n")
+	require.Equal(t, BreakerPolicy{Failures: 3, OpenTimeout: 30 * time.Second}, cp.Breaker)
+}
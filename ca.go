@@ -0,0 +1,159 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/msp"
+	"github.com/hyperledger/fabric-sdk-go/pkg/core/cryptosuite"
+	"github.com/hyperledger/fabric-sdk-go/pkg/gateway"
+)
+
+// Registration defines an identity registered on the fabric-ca of the
+// organization of the Client.
+type Registration struct {
+	Name string
+	// Type is the type of the identity, by default "client".
+	Type        string
+	Affiliation string
+	// Secret is the enrollment secret.  If empty, the fabric-ca generates it.
+	Secret string
+	// MaxEnrollments is the number of enrollments allowed.  Zero uses the
+	// default of the fabric-ca.
+	MaxEnrollments int
+	// Attributes are added to the enrollment certificates.
+	Attributes map[string]string
+}
+
+// RegisterUser registers the identity `r` with the registrar of the
+// certificate authority defined in the connection profile.  It returns the
+// enrollment secret.
+func (c *Client) RegisterUser(r Registration) (string, error) {
+	if !c.initialized {
+		return "", ErrClientNotInitialized
+	}
+	mc, err := c.mspClient()
+	if err != nil {
+		return "", err
+	}
+	req := &msp.RegistrationRequest{Name: r.Name, Type: r.Type, Affiliation: r.Affiliation,
+		Secret: r.Secret, MaxEnrollments: r.MaxEnrollments}
+	if req.Type == "" {
+		req.Type = "client"
+	}
+	names := make([]string, 0, len(r.Attributes))
+	for k := range r.Attributes {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		req.Attributes = append(req.Attributes, msp.Attribute{Name: k, Value: r.Attributes[k], ECert: true})
+	}
+	RedactSecret(r.Secret)
+	secret, err := mc.Register(req)
+	if err != nil {
+		Logr.Errorf("could not register %s: %v", r.Name, err)
+		return "", ErrCreateUser
+	}
+	RedactSecret(secret)
+	Logr.Infof("Registered user %s", r.Name)
+	return secret, nil
+}
+
+// EnrollUser enrolls the registered identity `name` with its enrollment
+// `secret` and stores it in the wallet of the Client.  It returns
+// ErrUserAlreadyExist if the wallet already holds `name`.
+func (c *Client) EnrollUser(name string, secret string) error {
+	if !c.initialized {
+		return ErrClientNotInitialized
+	}
+	if c.wallet.Exists(name) {
+		return ErrUserAlreadyExist
+	}
+	mc, err := c.mspClient()
+	if err != nil {
+		return err
+	}
+	RedactSecret(secret)
+	if err := mc.Enroll(name, msp.WithSecret(secret)); err != nil {
+		Logr.Errorf("could not enroll %s: %v", name, err)
+		return ErrInitUser
+	}
+	si, err := mc.GetSigningIdentity(name)
+	if err != nil {
+		Logr.Errorf("could not get signing identity of %s: %v", name, err)
+		return ErrInitUser
+	}
+	// the SDK keystore names the private keys after their SKI.
+	sdk, _, err := c.walletSDK()
+	if err != nil {
+		return err
+	}
+	backend, err := sdk.Config()
+	if err != nil {
+		return err
+	}
+	keyFile := filepath.Join(cryptosuite.ConfigFromBackend(backend).KeyStorePath(),
+		hex.EncodeToString(si.PrivateKey().SKI())+"_sk")
+	key, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		Logr.Errorf("could not read private key of %s: %v", name, err)
+		return ErrInitUser
+	}
+	id := gateway.NewX509Identity(si.Identifier().MSPID, string(si.EnrollmentCertificate()), string(key))
+	if err := c.wallet.Put(name, id); err != nil {
+		Logr.Errorf("could not store %s in wallet: %v", name, err)
+		return ErrWalletInitFailed
+	}
+	Logr.Infof("Enrolled user %s", name)
+	return nil
+}
+
+// RevokeUser revokes all the certificates of the identity `name` for
+// `reason`.  It returns the serial numbers of the revoked certificates.  The
+// wallet is not modified.
+func (c *Client) RevokeUser(name string, reason string) ([]string, error) {
+	if !c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	mc, err := c.mspClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := mc.Revoke(&msp.RevocationRequest{Name: name, Reason: reason})
+	if err != nil {
+		Logr.Errorf("could not revoke %s: %v", name, err)
+		return nil, err
+	}
+	serials := make([]string, len(resp.RevokedCerts))
+	for i, rc := range resp.RevokedCerts {
+		serials[i] = rc.Serial
+	}
+	Logr.Infof("Revoked user %s", name)
+	return serials, nil
+}
+
+// mspClient returns a fabric-ca client of the organization of the connection
+// profile.
+func (c *Client) mspClient() (*msp.Client, error) {
+	if err := c.begin(); err != nil {
+		return nil, err
+	}
+	defer c.end()
+	sdk, _, err := c.walletSDK()
+	if err != nil {
+		return nil, err
+	}
+	mc, err := msp.New(sdk.Context())
+	if err != nil {
+		Logr.Errorf("could not create CA client: %v", err)
+		return nil, ErrInitClient
+	}
+	return mc, nil
+}
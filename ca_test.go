@@ -0,0 +1,20 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_CA_NotInitialized(t *testing.T) {
+	c := &Client{}
+	_, err := c.RegisterUser(Registration{Name: "user2"})
+	require.ErrorIs(t, err, ErrClientNotInitialized)
+	require.ErrorIs(t, c.EnrollUser("user2", "pw"), ErrClientNotInitialized)
+	_, err = c.RevokeUser("user2", "")
+	require.ErrorIs(t, err, ErrClientNotInitialized)
+}
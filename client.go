@@ -1,17 +1,28 @@
-// v0.7.5
+// v0.17.3
 // Author: DIEHL E.
 // (C) Sony Pictures Entertainment, Jan 2021
 
 package blockchain
 
 import (
+	"context"
 	"os"
 	"path/filepath"
+	"sync"
+	"time"
 
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/logging"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/core"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/msp"
 	"github.com/hyperledger/fabric-sdk-go/pkg/core/config"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
 	"github.com/hyperledger/fabric-sdk-go/pkg/gateway"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -22,27 +33,73 @@ const (
 var Logr *logrus.Logger
 
 // Client is the structure handling the connection to the blockchain.
+//
+// A Client is safe for concurrent use by multiple goroutines, and so are its
+// Contract handles.  Close waits for the calls in flight to complete.  Calls
+// started after Close return ErrClientClosed.
 type Client struct {
 	gw          *gateway.Gateway
 	wallet      *gateway.Wallet
 	network     *gateway.Network
-	contract    *gateway.Contract
-	initialized bool
+	contract    transactor
+	initialized bool // set once by init before the Client is shared.
 	walletDir   string
 	local       bool // temporary fix for Bug v1.0.0-beta3.0.20201006151309-9c426dcc5096
+	cfg         *Configuration
+	provider    core.ConfigProvider // connection profile; nil means cfg.ConnectionFile
+	retry       RetryPolicy
+	health      HealthPolicy
+	breaker     *circuitBreaker // nil if disabled
+	submitLim   *limiter        // nil if disabled
+	evaluateLim *limiter        // nil if disabled
+	idemStore   IdempotencyStore
+	idemLocks   keyLocks
+	metrics     *clientMetrics // nil if disabled
+	tracer      trace.Tracer   // nil if disabled
+	propagator  propagation.TextMapPropagator
+
+	networksMu sync.Mutex
+	networks   map[string]*gateway.Network // networks by channel
+
+	ledgerMu       sync.Mutex
+	sdk            *fabsdk.FabricSDK          // used for the ledger access.  Lazily created.
+	identity       msp.SigningIdentity        // identity of the user within sdk.
+	channelClients map[string]*channel.Client // clients of sdk by channel
+
+	mu       sync.RWMutex // protects closed and closing
+	closed   bool
+	closing  chan struct{}  // closed by Close.  Lazily created.
+	inflight sync.WaitGroup // calls in progress
+}
+
+// transactor is the part of gateway.Contract used by the Client.  It allows
+// to replace the gateway by a fake backend in tests.
+type transactor interface {
+	Name() string
+	SubmitTransaction(name string, args ...string) ([]byte, error)
+	EvaluateTransaction(name string, args ...string) ([]byte, error)
 }
 
 // NewClient creates a new Client for the configuration defined by file `configFile`.
-//  If the user is not yet in the
-// wallet, it attempts to populate the wallet.  `options` may overwrite the data
-// provided by the configuration file.
+// If the user is not yet in the wallet, it attempts to populate the wallet.
+// `options` may overwrite the data provided by the configuration file.
 func NewClient(configFile string, path string, options ...ClientOption) (*Client, error) {
 	// collects the options
-	clOpts := clientOptions{user: "", walletDir: "", log: "bc.log"} // default values
+	clOpts := clientOptions{user: "", walletDir: ""} // default values
 	for _, option := range options {
 		option(&clOpts)
 	}
 
+	cp, err := loadConfiguration(configFile, path)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(cp, clOpts)
+}
+
+// loadConfiguration reads the configuration file `configFile` from directory
+// `path`.
+func loadConfiguration(configFile string, path string) (*Configuration, error) {
 	vi := viper.New()
 	vi.SetConfigName(configFile)
 	vi.AddConfigPath(path)
@@ -53,35 +110,149 @@ func NewClient(configFile string, path string, options ...ClientOption) (*Client
 		Logr.Fatalf("could not load the configuration from %s: %v", configFile, err)
 		return nil, ErrWalletInitFailed
 	}
+	return cp, nil
+}
 
+// newClient creates a new Client for the configuration `cp` overwritten by
+// `clOpts`.  `cp` is modified by the options.
+func newClient(cp *Configuration, clOpts clientOptions) (*Client, error) {
 	if clOpts.user != "" {
 		// overwrites the potential User defined in `configFile`
 		cp.User = clOpts.user
 	}
+	if clOpts.contractName != "" {
+		// overwrites the potential ContractName defined in `configFile`
+		cp.ContractName = clOpts.contractName
+	}
+
+	if clOpts.log != "" && clOpts.log != cp.Log.File {
+		// overwrites the log file defined in `configFile`
+		lc := cp.Log
+		if lc.Format == "" {
+			lc = DefaultLogConfig()
+		}
+		lc.File = clOpts.log
+		if err := ConfigureLog(lc); err != nil {
+			return nil, err
+		}
+		cp.Log = lc
+	}
 
 	// if the options did not define the wallet dir, then set default.
 	if clOpts.walletDir == "" {
-		clOpts.walletDir = filepath.Join(cp.configDir, "wallet")
+		clOpts.walletDir = cp.WalletDir()
 	}
 
-	c := &Client{walletDir: clOpts.walletDir}
-	err = c.init(cp)
+	c := &Client{walletDir: clOpts.walletDir, provider: clOpts.provider, retry: cp.Retry,
+		health: cp.Health, idemStore: clOpts.idemStore}
+	if clOpts.retry != nil {
+		c.retry = *clOpts.retry
+	}
+	if clOpts.health != nil {
+		c.health = *clOpts.health
+	}
+	bp := cp.Breaker
+	if clOpts.breaker != nil {
+		bp = *clOpts.breaker
+	}
+	if bp.Failures > 0 {
+		c.breaker = newCircuitBreaker(bp)
+	}
+	sl, el := cp.SubmitLimit, cp.EvaluateLimit
+	if clOpts.submitLimit != nil {
+		sl = *clOpts.submitLimit
+	}
+	if clOpts.evaluateLimit != nil {
+		el = *clOpts.evaluateLimit
+	}
+	c.submitLim, c.evaluateLim = newLimiter(sl), newLimiter(el)
+	if clOpts.tracerProvider != nil {
+		c.tracer = clOpts.tracerProvider.Tracer(TracerName)
+	}
+	c.propagator = clOpts.propagator
+	if clOpts.metrics != nil {
+		m, err := newClientMetrics(clOpts.metrics)
+		if err != nil {
+			return c, err
+		}
+		c.metrics = m
+	}
+	err := c.init(cp)
+	if err == nil {
+		c.metrics.observeWallet(c.wallet)
+	}
 	return c, err
 }
 
-// Close closes the Client.
+// Close closes the Client.  It waits for the calls in flight to complete.
+// Closing a closed Client only waits for these calls.
 func (c *Client) Close() {
-	if c.initialized {
+	c.mu.Lock()
+	first := !c.closed
+	c.closed = true
+	if first && c.closing != nil {
+		close(c.closing)
+	}
+	c.mu.Unlock()
+
+	c.inflight.Wait()
+	if !first {
+		return
+	}
+	if c.initialized && c.gw != nil {
 		c.gw.Close()
 	}
+	c.closeLedger()
+}
+
+// begin registers a call in flight.  It fails if the Client is closed.  Every
+// successful begin must be followed by end.
+func (c *Client) begin() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if !c.initialized {
+		return ErrClientNotInitialized
+	}
+	if c.closed {
+		return ErrClientClosed
+	}
+	c.inflight.Add(1)
+	c.metrics.begin()
+	return nil
+}
+
+// closingCh returns a channel closed when the Client closes.
+func (c *Client) closingCh() <-chan struct{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closing == nil {
+		c.closing = make(chan struct{})
+		if c.closed {
+			close(c.closing)
+		}
+	}
+	return c.closing
+}
+
+// end unregisters a call in flight.
+func (c *Client) end() {
+	c.metrics.end()
+	c.inflight.Done()
 }
 
 // Invoke submits the transaction `fn` with the argumenst `args`.
 func (c *Client) Invoke(fn string, args ...string) ([]byte, error) {
+	return c.InvokeContext(context.Background(), fn, args...)
+}
+
+// InvokeContext submits the transaction `fn` with the arguments `args`.  `ctx`
+// bounds the waits for the rate limiter and between retries, and carries the
+// trace context.
+func (c *Client) InvokeContext(ctx context.Context, fn string, args ...string) ([]byte, error) {
 	if !c.initialized {
 		return nil, ErrClientNotInitialized
 	}
-	return c.contract.SubmitTransaction(fn, args...)
+	return c.submit(ctx, c.mainContract(), fn, args...)
 }
 
 // func (c *Client) Init(configFile string, channelID string, chaincodeID string, user string, credPath string) error {
@@ -90,20 +261,118 @@ func (c *Client) Invoke(fn string, args ...string) ([]byte, error) {
 
 // Query submits a transactiion `fn`with the arguments `args`.
 func (c *Client) Query(fn string, args ...string) ([]byte, error) {
-	if !c.initialized {
-		return nil, ErrClientNotInitialized
-	}
 	// if c.local {
 	// 	return c.contract.SubmitTransaction(fn, args...)
 	// }
 
-	return c.contract.EvaluateTransaction(fn, args...)
+	return c.QueryContext(context.Background(), fn, args...)
+}
+
+// QueryContext evaluates the transaction `fn` with the arguments `args`.  `ctx`
+// is used as by InvokeContext.
+func (c *Client) QueryContext(ctx context.Context, fn string, args ...string) ([]byte, error) {
+	if !c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	return c.evaluate(ctx, c.mainContract(), fn, args...)
+}
+
+// InvokeContract submits the transaction `fn` of contract `contractName` of the
+// chaincode of the Client, regardless of the contract name of the configuration.
+func (c *Client) InvokeContract(contractName string, fn string, args ...string) ([]byte, error) {
+	if !c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	ct, err := c.Contract(c.cfg.ChannelID, c.cfg.ChainCodeID, contractName)
+	if err != nil {
+		return nil, err
+	}
+	return ct.Invoke(fn, args...)
+}
+
+// ChaincodeContract returns the handle on contract `contractName` of the
+// chaincode of the Client, regardless of the contract name of the
+// configuration.  If `contractName` is empty, it is the default contract.
+func (c *Client) ChaincodeContract(contractName string) (*Contract, error) {
+	if !c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	return c.Contract(c.cfg.ChannelID, c.cfg.ChainCodeID, contractName)
+}
+
+// QueryContract evaluates the transaction `fn` of contract `contractName` of the
+// chaincode of the Client, regardless of the contract name of the configuration.
+func (c *Client) QueryContract(contractName string, fn string, args ...string) ([]byte, error) {
+	if !c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	ct, err := c.Contract(c.cfg.ChannelID, c.cfg.ChainCodeID, contractName)
+	if err != nil {
+		return nil, err
+	}
+	return ct.Query(fn, args...)
+}
+
+// submit submits the transaction `fn` with the arguments `args` to `ct`.
+// All the submissions of the Client and of its Contract handles go through it.
+func (c *Client) submit(ctx context.Context, ct *Contract, fn string, args ...string) (res []byte, err error) {
+	if err := c.begin(); err != nil {
+		return nil, err
+	}
+	defer c.end()
+	if err := checkFunction(ct.contract, fn); err != nil {
+		return nil, err
+	}
+	entry := c.callLog(ct, fn)
+	entry.WithField("args", RedactArgs(fn, args)).Debug("submit")
+	ctx, span := c.startSpan(ctx, "fabric.submit", ct, fn)
+	var txID string
+	defer func(start time.Time) {
+		c.metrics.call("submit", fn, time.Since(start), err)
+		logCall(entry, txID, start, err)
+		endSpan(span, err)
+	}(time.Now())
+	if err := c.submitLim.wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.withRetry(ctx, fn, true, c.guard(func() (res []byte, err error) {
+		res, txID, err = c.transact(ctx, ct, true, fn, args)
+		return res, err
+	}))
+}
+
+// evaluate evaluates the transaction `fn` with the arguments `args` on `ct`.
+// All the evaluations of the Client and of its Contract handles go through it.
+func (c *Client) evaluate(ctx context.Context, ct *Contract, fn string, args ...string) (res []byte, err error) {
+	if err := c.begin(); err != nil {
+		return nil, err
+	}
+	defer c.end()
+	if err := checkFunction(ct.contract, fn); err != nil {
+		return nil, err
+	}
+	entry := c.callLog(ct, fn)
+	entry.WithField("args", RedactArgs(fn, args)).Debug("evaluate")
+	ctx, span := c.startSpan(ctx, "fabric.evaluate", ct, fn)
+	defer func(start time.Time) {
+		c.metrics.call("evaluate", fn, time.Since(start), err)
+		logCall(entry, "", start, err)
+		endSpan(span, err)
+	}(time.Now())
+	if err := c.evaluateLim.wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.withRetry(ctx, fn, false, c.guard(func() (res []byte, err error) {
+		res, _, err = c.transact(ctx, ct, false, fn, args)
+		return res, err
+	}))
 }
 
 // init setups the discovery conditions, initializes the wallet if needed,
 // and sets up contract.
 func (c *Client) init(cp *Configuration) error {
 	c.initialized = false
+	c.cfg = cp
 	c.local = cp.gatewayNotLocal // temporary
 	if !cp.gatewayNotLocal {
 		os.Setenv(cDiscoveryKey, "true")
@@ -130,7 +399,7 @@ func (c *Client) init(cp *Configuration) error {
 	Logr.Debug("wallet operational")
 	Logr.Debugf("Connection file %s", filepath.Clean(cp.ConnectionFile))
 	c.gw, err = gateway.Connect(
-		gateway.WithConfig(config.FromFile(filepath.Clean(cp.ConnectionFile))),
+		gateway.WithConfig(c.configProvider()),
 		gateway.WithIdentity(c.wallet, cp.User),
 	)
 	if err != nil {
@@ -138,17 +407,34 @@ func (c *Client) init(cp *Configuration) error {
 		return err
 	}
 	Logr.Debug("gateway connected")
-	c.network, err = c.gw.GetNetwork(cp.ChannelID)
+	c.networks = make(map[string]*gateway.Network)
+	c.network, err = c.getNetwork(cp.ChannelID)
 	if err != nil {
 		Logr.Errorf("Failed to get network: %v", err)
 		os.Exit(1)
 	}
 	Logr.Debug("network acquired")
-	c.contract = c.network.GetContract(cp.ChainCodeID)
+	if cp.ContractName != "" {
+		if err := checkContractName(cp.ContractName); err != nil {
+			Logr.Errorf("invalid contract name %q", cp.ContractName)
+			return err
+		}
+		c.contract = gatewayContract{c.network.GetContractWithName(cp.ChainCodeID, cp.ContractName)}
+	} else {
+		c.contract = gatewayContract{c.network.GetContract(cp.ChainCodeID)}
+	}
 	c.initialized = true
 	return nil
 }
 
+// configProvider returns the provider of the connection profile of the Client.
+func (c *Client) configProvider() core.ConfigProvider {
+	if c.provider != nil {
+		return withSDKLogLevels(c.provider)
+	}
+	return withSDKLogLevels(config.FromFile(filepath.Clean(c.cfg.ConnectionFile)))
+}
+
 // func populateWallet(wallet *gateway.Wallet, cp *Configuration) error {
 // 	fs1 := &FabricSetup{}
 // 	fs1.Configuration = *cp
@@ -200,15 +486,27 @@ func (c *Client) init(cp *Configuration) error {
 // }
 
 type clientOptions struct {
-	user      string
-	walletDir string
-	log       string
+	user           string
+	walletDir      string
+	log            string
+	contractName   string
+	provider       core.ConfigProvider // set by ClientPool to share the connection profile.
+	retry          *RetryPolicy
+	breaker        *BreakerPolicy
+	health         *HealthPolicy
+	submitLimit    *RateLimit
+	evaluateLimit  *RateLimit
+	idemStore      IdempotencyStore
+	metrics        prometheus.Registerer
+	tracerProvider trace.TracerProvider
+	propagator     propagation.TextMapPropagator
 }
 
 // ClientOption allows to parameterize the NewClient function.
 type ClientOption func(opts *clientOptions)
 
-// WithLog sets the lofg file to `name` instead of default "bc.log".
+// WithLog sets the log file to `name` instead of the file of the section [log]
+// of the configuration, by default "bc.log".
 func WithLog(name string) ClientOption {
 	return func(cp *clientOptions) {
 		cp.log = name
@@ -223,6 +521,14 @@ func WithUser(name string) ClientOption {
 	}
 }
 
+// WithContractName sets the contract of a multi-contract chaincode to `name`
+// regardless of what was in the configuration file.
+func WithContractName(name string) ClientOption {
+	return func(cp *clientOptions) {
+		cp.contractName = name
+	}
+}
+
 // WithWallet sets the directory of the wallet instead of the default "wallet"
 // directory.
 func WithWallet(dir string) ClientOption {
@@ -232,23 +538,18 @@ func WithWallet(dir string) ClientOption {
 }
 
 func init() {
-	// logging.SetLevel("fabsdk/fab", logging.ERROR)
+	logging.Initialize(sdkLoggerProvider{})
 	initLogr("bc.log") // default logging file is "bc.log"
 }
 
-// initLogr initializes the logger Logr to write in the file defined by `fileName`.
-//  It sets also logger "fabsdk/fab" to Error level.
+// initLogr initializes the logger Logr to write in the file defined by `fileName`
+// with the default rotation.
 func initLogr(fileName string) {
 	Logr = logrus.New()
 
-	file, err := os.OpenFile(fileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err == nil {
-
-		Logr.Out = file
-	} else {
-		Logr.Infof("Failed to log to file %s, using default stderr", fileName)
-
-	}
-	Logr.Level = logrus.InfoLevel
+	lc := DefaultLogConfig()
+	lc.File = fileName
+	_ = ConfigureLog(lc)
+	Logr.AddHook(redactHook{})
 	Logr.Infof("Blockchain version %s", cVersion)
 }
@@ -0,0 +1,232 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
+
+	"blockchain"
+)
+
+// systemContract is the contract of the contract API that every chaincode
+// exposes.  It is never generated.
+const systemContract = "org.hyperledger.fabric"
+
+// generator emits the typed client of the contracts of a chaincode.
+type generator struct {
+	md  *blockchain.Metadata
+	buf bytes.Buffer
+}
+
+// generate returns the formatted Go source of package `pkg` holding a typed
+// client for each contract of `md`.  If `contracts` is not empty, only the
+// listed contracts are generated.
+func generate(md *blockchain.Metadata, pkg string, contracts ...string) ([]byte, error) {
+	g := &generator{md: md}
+	g.printf("// Code generated by ccgen from the chaincode metadata. DO NOT EDIT.\n\n")
+	g.printf("package %s\n\n", pkg)
+	g.printf("import \"blockchain\"\n\n")
+
+	var names []string
+	for name := range md.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		g.genStruct(name, md.Components.Schemas[name])
+	}
+
+	names = names[:0]
+	for name := range md.Contracts {
+		if name == systemContract || (len(contracts) > 0 && !contains(contracts, name)) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no contract to generate")
+	}
+	for _, name := range names {
+		g.genContract(md.Contracts[name])
+	}
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("generated code is invalid: %w", err)
+	}
+	return src, nil
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// genStruct emits the struct of component `name`.
+func (g *generator) genStruct(name string, s *blockchain.Schema) {
+	var props []string
+	for p := range s.Properties {
+		props = append(props, p)
+	}
+	sort.Strings(props)
+	g.printf("// %s is the component %s of the chaincode.\n", exported(name), name)
+	g.printf("type %s struct {\n", exported(name))
+	for _, p := range props {
+		tag := p
+		if !contains(s.Required, p) {
+			tag += ",omitempty"
+		}
+		g.printf("\t%s %s `json:\"%s\"`\n", exported(p), g.goType(s.Properties[p]), tag)
+	}
+	g.printf("}\n\n")
+}
+
+// genContract emits the client type of contract `ct` and its methods.
+func (g *generator) genContract(ct *blockchain.ContractMetadata) {
+	typ := exported(ct.Name)
+	name := ct.Name
+	if ct.Default {
+		name = ""
+	}
+	g.printf("// %s is the typed client of contract %s.\n", typ, ct.Name)
+	g.printf("// It calls the contract through its own handle, regardless of the contract\n")
+	g.printf("// name of the configuration of the Client.\n")
+	g.printf("type %s struct {\n\tct *blockchain.Contract\n}\n\n", typ)
+	g.printf("// New%s returns the client of contract %s of the chaincode of `c`.\n", typ, ct.Name)
+	g.printf("func New%s(c *blockchain.Client) (*%s, error) {\n", typ, typ)
+	g.printf("\tct, err := c.ChaincodeContract(%q)\n", name)
+	g.printf("\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+	g.printf("\treturn &%s{ct: ct}, nil\n}\n\n", typ)
+
+	txs := append([]*blockchain.TransactionMetadata(nil), ct.Transactions...)
+	sort.Slice(txs, func(i, j int) bool { return txs[i].Name < txs[j].Name })
+	for _, tx := range txs {
+		g.genMethod(typ, ct, tx)
+	}
+}
+
+// genMethod emits the method of transaction `tx` of contract `ct`.
+func (g *generator) genMethod(typ string, ct *blockchain.ContractMetadata, tx *blockchain.TransactionMetadata) {
+	var params, args []string
+	for i, p := range tx.Parameters {
+		name := paramName(p.Name, i)
+		params = append(params, name+" "+g.goType(p.Schema))
+		args = append(args, name)
+	}
+	call, verb := "QueryJSON", "evaluates"
+	if tx.IsSubmit() {
+		call, verb = "InvokeJSON", "submits"
+	}
+	// the handle of the contract qualifies the function.
+	fn := tx.Name
+	argList := ""
+	if len(args) > 0 {
+		argList = ", " + strings.Join(args, ", ")
+	}
+
+	g.printf("// %s %s the transaction %s.\n", exported(tx.Name), verb, ct.QualifiedName(tx.Name))
+	if tx.Returns == nil || tx.Returns.Schema == nil {
+		g.printf("func (cc *%s) %s(%s) error {\n", typ, exported(tx.Name), strings.Join(params, ", "))
+		g.printf("\treturn cc.ct.%s(%q, nil%s)\n}\n\n", call, fn, argList)
+		return
+	}
+	rt := g.goType(tx.Returns.Schema)
+	g.printf("func (cc *%s) %s(%s) (%s, error) {\n", typ, exported(tx.Name), strings.Join(params, ", "), rt)
+	g.printf("\tvar res %s\n", rt)
+	g.printf("\terr := cc.ct.%s(%q, &res%s)\n", call, fn, argList)
+	g.printf("\treturn res, err\n}\n\n")
+}
+
+// goType returns the Go type matching the schema `s`.
+func (g *generator) goType(s *blockchain.Schema) string {
+	if s == nil {
+		return "interface{}"
+	}
+	if ref := s.RefName(); ref != "" {
+		if _, ok := g.md.Components.Schemas[ref]; ok {
+			return "*" + exported(ref)
+		}
+		return "interface{}"
+	}
+	switch s.Type.Main() {
+	case "string":
+		return "string"
+	case "boolean":
+		return "bool"
+	case "integer":
+		switch s.Format {
+		case "int32":
+			return "int32"
+		case "int64":
+			return "int64"
+		}
+		return "int"
+	case "number":
+		if s.Format == "float" {
+			return "float32"
+		}
+		return "float64"
+	case "array":
+		return "[]" + g.goType(s.Items)
+	case "object":
+		if s.ID != "" {
+			if _, ok := g.md.Components.Schemas[s.ID]; ok {
+				return "*" + exported(s.ID)
+			}
+		}
+		return "map[string]interface{}"
+	}
+	return "interface{}"
+}
+
+// exported returns `name` as an exported Go identifier.
+func exported(name string) string {
+	var b strings.Builder
+	up := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			up = true
+			continue
+		}
+		if up {
+			r = unicode.ToUpper(r)
+			up = false
+		}
+		b.WriteRune(r)
+	}
+	s := b.String()
+	if s == "" || unicode.IsDigit(rune(s[0])) {
+		s = "X" + s
+	}
+	return s
+}
+
+// paramName returns a valid Go parameter name for parameter `name` at position `i`.
+func paramName(name string, i int) string {
+	if name == "" {
+		return fmt.Sprintf("arg%d", i)
+	}
+	s := exported(name)
+	s = strings.ToLower(s[:1]) + s[1:]
+	if token.Lookup(s).IsKeyword() || s == "cc" || s == "res" || s == "err" {
+		s += "_"
+	}
+	return s
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
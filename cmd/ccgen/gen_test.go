@@ -0,0 +1,54 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"blockchain"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_generate(t *testing.T) {
+	data, err := ioutil.ReadFile(filepath.Join("..", "..", "testdata", "metadata.json"))
+	require.NoError(t, err)
+	md, err := blockchain.ParseMetadata(data)
+	require.NoError(t, err)
+
+	src, err := generate(md, "fabcar")
+	require.NoError(t, err)
+	s := string(src)
+	require.Contains(t, s, "package fabcar")
+	require.Contains(t, s, "type Car struct")
+	require.Contains(t, s, "Tags   []string `json:\"tags,omitempty\"`")
+	require.Contains(t, s, `func (cc *SmartContract) CreateCar(carNumber string, make string, model string, colour string, owner string) error {`)
+	require.Contains(t, s, `cc.ct.InvokeJSON("CreateCar", nil, carNumber, make, model, colour, owner)`)
+	require.Contains(t, s, `func (cc *SmartContract) QueryAllCars() ([]*QueryResult, error) {`)
+	require.Contains(t, s, `cc.ct.QueryJSON("QueryCar", &res, carNumber)`)
+	require.Contains(t, s, `func (cc *AuditContract) Record(type_ string, car *Car, score float64) (bool, error) {`)
+	require.Contains(t, s, `ct, err := c.ChaincodeContract("")`)
+	require.Contains(t, s, `ct, err := c.ChaincodeContract("AuditContract")`)
+	require.Contains(t, s, `cc.ct.InvokeJSON("Record", &res, type_, car, score)`)
+	require.NotContains(t, s, `"AuditContract:Record"`)
+	require.NotContains(t, s, "GetMetadata")
+
+	src, err = generate(md, "fabcar", "AuditContract")
+	require.NoError(t, err)
+	require.NotContains(t, string(src), "SmartContract")
+
+	_, err = generate(md, "fabcar", "Unknown")
+	require.Error(t, err)
+}
+
+func Test_names(t *testing.T) {
+	require.Equal(t, "CreateCar", exported("createCar"))
+	require.Equal(t, "OrgExampleAsset", exported("org.example.asset"))
+	require.Equal(t, "X2fa", exported("2fa"))
+	require.Equal(t, "carNumber", paramName("CarNumber", 0))
+	require.Equal(t, "func_", paramName("func", 0))
+	require.Equal(t, "arg2", paramName("", 2))
+}
@@ -0,0 +1,94 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+// Command ccgen generates a typed Go client for the contracts of a chaincode
+// written with the contract API.  The metadata of the chaincode is either read
+// from a file saved previously or queried through the connection defined by a
+// blockchain TOML configuration file.
+//
+//	ccgen -metadata fabcar.json -package fabcar -out fabcar/client.go
+//	ccgen -config config -path ./testdata -package fabcar -out fabcar/client.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"blockchain"
+)
+
+func main() {
+	mdFile := flag.String("metadata", "", "file holding the JSON metadata of the chaincode")
+	cfgFile := flag.String("config", "config", "name of the TOML configuration file, used if -metadata is empty")
+	cfgPath := flag.String("path", ".", "directory of the TOML configuration file")
+	pkg := flag.String("package", "chaincode", "name of the generated package")
+	out := flag.String("out", "", "output file (default stdout)")
+	save := flag.String("save", "", "saves the queried metadata in this file")
+	var contracts stringList
+	flag.Var(&contracts, "contract", "contract to generate (repeatable, default all)")
+	flag.Parse()
+
+	data, err := loadMetadata(*mdFile, *cfgFile, *cfgPath)
+	if err != nil {
+		fail(err)
+	}
+	if *save != "" {
+		if err := ioutil.WriteFile(*save, data, 0644); err != nil {
+			fail(err)
+		}
+	}
+	md, err := blockchain.ParseMetadata(data)
+	if err != nil {
+		fail(err)
+	}
+	src, err := generate(md, *pkg, contracts...)
+	if err != nil {
+		fail(err)
+	}
+	if *out == "" {
+		os.Stdout.Write(src)
+		return
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		fail(err)
+	}
+}
+
+// loadMetadata reads the metadata from `file` if not empty, else queries it from
+// the chaincode defined by the configuration `cfgFile` in directory `cfgPath`.
+func loadMetadata(file string, cfgFile string, cfgPath string) ([]byte, error) {
+	if file != "" {
+		return ioutil.ReadFile(file)
+	}
+	c, err := blockchain.NewClient(cfgFile, cfgPath)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+	// the metadata are served by the system contract, not by a named contract.
+	ct, err := c.ChaincodeContract("")
+	if err != nil {
+		return nil, err
+	}
+	return ct.Query(blockchain.MetadataFunction)
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "ccgen: %v\n", err)
+	os.Exit(1)
+}
+
+// stringList is a repeatable string flag.
+type stringList []string
+
+func (s *stringList) String() string {
+	return fmt.Sprint([]string(*s))
+}
+
+func (s *stringList) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
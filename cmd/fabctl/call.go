@@ -0,0 +1,70 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package main
+
+import (
+	"blockchain"
+)
+
+const callFlags = "[-contract name] [-channel ch] [-chaincode cc] fn [args...]"
+
+func runInvoke(e *env, args []string) (interface{}, error) {
+	return e.call("invoke", args, (*blockchain.Contract).Invoke)
+}
+
+func runQuery(e *env, args []string) (interface{}, error) {
+	return e.call("query", args, (*blockchain.Contract).Query)
+}
+
+// call submits, or evaluates, with `do` the transaction of the command line
+// `args` of command `name`.
+func (e *env) call(name string, args []string,
+	do func(ct *blockchain.Contract, fn string, args ...string) ([]byte, error)) (interface{}, error) {
+	fs := e.flagSet(name, name+" "+callFlags)
+	contract := fs.String("contract", "", "named contract of the configuration")
+	channel := fs.String("channel", "", "channel, instead of the one of the configuration")
+	chaincode := fs.String("chaincode", "", "chaincode, instead of the one of the configuration")
+	if err := fs.Parse(args); err != nil || fs.NArg() == 0 {
+		if err == nil {
+			fs.Usage()
+		}
+		return nil, errUsage
+	}
+	c, err := e.client()
+	if err != nil {
+		return nil, err
+	}
+	ct, err := e.contract(c, *contract, *channel, *chaincode)
+	if err != nil {
+		return nil, err
+	}
+	res, err := do(ct, fs.Arg(0), fs.Args()[1:]...)
+	if err != nil {
+		return nil, err
+	}
+	return payload(res), nil
+}
+
+// contract returns the named contract `name` if not empty, else the contract of
+// `channel` and `chaincode` that default to the ones of the configuration.
+func (e *env) contract(c *blockchain.Client, name string, channel string, chaincode string) (*blockchain.Contract, error) {
+	if name != "" {
+		return c.NamedContract(name)
+	}
+	cfg, err := e.config()
+	if err != nil {
+		return nil, err
+	}
+	if channel == "" {
+		channel = cfg.ChannelID
+	}
+	if chaincode == "" {
+		chaincode = cfg.ChainCodeID
+	}
+	if channel == cfg.ChannelID && chaincode == cfg.ChainCodeID && cfg.ContractName != "" {
+		return c.Contract(channel, chaincode, cfg.ContractName)
+	}
+	return c.Contract(channel, chaincode)
+}
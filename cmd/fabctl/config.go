@@ -0,0 +1,117 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"blockchain"
+)
+
+// configView is the printed configuration.  The enrollment secret is masked.
+type configView struct {
+	ConnectionFile string                      `json:"connection"`
+	ChannelID      string                      `json:"channel"`
+	ChainCodeID    string                      `json:"chaincode"`
+	ContractName   string                      `json:"contractName,omitempty"`
+	User           string                      `json:"user"`
+	UserPwd        string                      `json:"userPwd,omitempty"`
+	OrgName        string                      `json:"org,omitempty"`
+	PeerOrg        string                      `json:"mspId,omitempty"`
+	Wallet         string                      `json:"wallet"`
+	Contracts      []blockchain.ContractConfig `json:"contracts,omitempty"`
+	Retry          map[string]interface{}      `json:"retry"`
+	Breaker        blockchain.BreakerPolicy    `json:"breaker"`
+	Health         blockchain.HealthPolicy     `json:"health"`
+	SubmitLimit    blockchain.RateLimit        `json:"submitLimit"`
+	EvaluateLimit  blockchain.RateLimit        `json:"evaluateLimit"`
+	Log            blockchain.LogConfig        `json:"log"`
+}
+
+func runConfig(e *env, args []string) (interface{}, error) {
+	return e.subcommand("config", args, map[string]func(e *env, args []string) (interface{}, error){
+		"validate": configValidate,
+		"show":     configShow,
+	})
+}
+
+// configValidate loads the configuration and checks that the files it refers
+// to exist.  It does not connect to the network.
+func configValidate(e *env, args []string) (interface{}, error) {
+	if len(args) != 0 {
+		fmt.Fprintln(e.stderr, "usage: fabctl config validate")
+		return nil, errUsage
+	}
+	cfg, err := e.config()
+	if err != nil {
+		return nil, err
+	}
+	var problems []string
+	if cfg.ChannelID == "" {
+		problems = append(problems, "no channel")
+	}
+	if cfg.ChainCodeID == "" {
+		problems = append(problems, "no chaincode")
+	}
+	if cfg.User == "" {
+		problems = append(problems, "no user")
+	}
+	if _, err := os.Stat(cfg.ConnectionFile); err != nil {
+		problems = append(problems, "connection profile: "+err.Error())
+	}
+	w, err := e.wallet()
+	if err != nil {
+		problems = append(problems, "wallet: "+err.Error())
+	} else if cfg.User != "" && !w.Exists(cfg.User) {
+		problems = append(problems, "user "+cfg.User+" not in the wallet")
+	}
+	if len(problems) > 0 {
+		printJSON(e.stdout, map[string]interface{}{"valid": false, "problems": problems})
+		return nil, fmt.Errorf("invalid configuration %s", e.cfgFile)
+	}
+	return map[string]interface{}{"valid": true}, nil
+}
+
+func configShow(e *env, args []string) (interface{}, error) {
+	if len(args) != 0 {
+		fmt.Fprintln(e.stderr, "usage: fabctl config show")
+		return nil, errUsage
+	}
+	cfg, err := e.config()
+	if err != nil {
+		return nil, err
+	}
+	v := configView{
+		ConnectionFile: cfg.ConnectionFile,
+		ChannelID:      cfg.ChannelID,
+		ChainCodeID:    cfg.ChainCodeID,
+		ContractName:   cfg.ContractName,
+		User:           cfg.User,
+		OrgName:        cfg.OrgName,
+		PeerOrg:        cfg.PeerOrg,
+		Wallet:         cfg.WalletDir(),
+		Contracts:      cfg.Contracts,
+		Retry: map[string]interface{}{
+			"maxAttempts":    cfg.Retry.MaxAttempts,
+			"initialBackoff": cfg.Retry.InitialBackoff.String(),
+			"maxBackoff":     cfg.Retry.MaxBackoff.String(),
+			"multiplier":     cfg.Retry.Multiplier,
+			"jitter":         cfg.Retry.Jitter,
+		},
+		Breaker:       cfg.Breaker,
+		Health:        cfg.Health,
+		SubmitLimit:   cfg.SubmitLimit,
+		EvaluateLimit: cfg.EvaluateLimit,
+		Log:           cfg.Log,
+	}
+	if e.walletDir != "" {
+		v.Wallet = e.walletDir
+	}
+	if cfg.UserPwd != "" {
+		v.UserPwd = blockchain.Masked
+	}
+	return v, nil
+}
@@ -0,0 +1,77 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func runLedger(e *env, args []string) (interface{}, error) {
+	return e.subcommand("ledger", args, map[string]func(e *env, args []string) (interface{}, error){
+		"info":  ledgerInfo,
+		"block": ledgerBlock,
+		"tx":    ledgerTx,
+	})
+}
+
+func ledgerInfo(e *env, args []string) (interface{}, error) {
+	if len(args) != 0 {
+		fmt.Fprintln(e.stderr, "usage: fabctl ledger info")
+		return nil, errUsage
+	}
+	c, err := e.client()
+	if err != nil {
+		return nil, err
+	}
+	h, err := c.BlockHeight()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := e.config()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"channel": cfg.ChannelID, "height": h}, nil
+}
+
+func ledgerBlock(e *env, args []string) (interface{}, error) {
+	if len(args) > 1 {
+		fmt.Fprintln(e.stderr, "usage: fabctl ledger block [number]")
+		fmt.Fprintln(e.stderr, "  the last block if the number is absent")
+		return nil, errUsage
+	}
+	var n uint64
+	if len(args) == 1 {
+		var err error
+		if n, err = strconv.ParseUint(args[0], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid block number %q", args[0])
+		}
+	}
+	c, err := e.client()
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 {
+		h, err := c.BlockHeight()
+		if err != nil {
+			return nil, err
+		}
+		n = h - 1
+	}
+	return c.Block(n)
+}
+
+func ledgerTx(e *env, args []string) (interface{}, error) {
+	if len(args) != 1 {
+		fmt.Fprintln(e.stderr, "usage: fabctl ledger tx txid")
+		return nil, errUsage
+	}
+	c, err := e.client()
+	if err != nil {
+		return nil, err
+	}
+	return c.TransactionStatus(args[0])
+}
@@ -0,0 +1,241 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+// Command fabctl operates a Hyperledger Fabric network through the blockchain
+// package.  It reads the same TOML configuration file as the library and
+// prints its results in JSON on the standard output.
+//
+//	fabctl [-config config] [-path .] [-user name] [-wallet dir] [-v] command [arguments]
+//
+//	fabctl invoke createCar CAR1 VW Golf blue Bob
+//	fabctl query queryCar CAR1
+//	fabctl wallet list|import|export|remove
+//	fabctl user register|enroll|revoke
+//	fabctl ledger info|block|tx
+//	fabctl config validate|show
+//	fabctl shell
+//	fabctl serve -auth auth.json
+//
+// The shell evaluates or submits the functions of the chaincode interactively.
+// It completes the function names from the metadata of the chaincode with Tab
+// and keeps the history of the commands in ~/.fabctl_history.
+//
+// The command serve runs the REST gateway of blockchain.Server until
+// interrupted.  The file of flag -auth maps the callers to the users of the
+// wallet:
+//
+//	{"apiKeys": {"secret-key": "user1"}, "certUsers": {"billing-service": "user2"}}
+//
+// With flag -grpc, it serves also the gRPC service Ledger of package ledgerpb
+// with the same callers.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"blockchain"
+)
+
+// errUsage reports a bad command line.  The usage is already printed.
+var errUsage = errors.New("usage")
+
+var errExists = errors.New("identity already in the wallet")
+
+// command is a command of fabctl.  It returns the value printed in JSON.
+type command struct {
+	usage string
+	run   func(e *env, args []string) (interface{}, error)
+}
+
+var commands = map[string]command{
+	"invoke": {"invoke " + callFlags, runInvoke},
+	"query":  {"query " + callFlags, runQuery},
+	"wallet": {"wallet list|import|export|remove", runWallet},
+	"user":   {"user register|enroll|revoke", runUser},
+	"ledger": {"ledger info|block|tx", runLedger},
+	"config": {"config validate|show", runConfig},
+	"shell":  {"shell [-history file]", runShell},
+	"serve":  {serveUsage, runServe},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command line `args` and returns the exit code.
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	e := &env{stdin: stdin, stdout: stdout, stderr: stderr}
+	fs := flag.NewFlagSet("fabctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&e.cfgFile, "config", "config", "name of the TOML configuration file")
+	fs.StringVar(&e.path, "path", ".", "directory of the TOML configuration file")
+	fs.StringVar(&e.user, "user", "", "user of the wallet, instead of the one of the configuration")
+	fs.StringVar(&e.walletDir, "wallet", "", "directory of the wallet, instead of the one of the configuration")
+	verbose := fs.Bool("v", false, "logs at debug level on stderr")
+	fs.Usage = func() { usage(fs) }
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *verbose {
+		lc := blockchain.DefaultLogConfig()
+		lc.Destination, lc.Level = blockchain.LogToStderr, "debug"
+		blockchain.ConfigureLog(lc)
+	}
+	if fs.NArg() == 0 {
+		usage(fs)
+		return 2
+	}
+	cmd, ok := commands[fs.Arg(0)]
+	if !ok {
+		fmt.Fprintf(stderr, "fabctl: unknown command %q\n", fs.Arg(0))
+		usage(fs)
+		return 2
+	}
+	defer e.close()
+	res, err := cmd.run(e, fs.Args()[1:])
+	if err == errUsage {
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "fabctl: %v\n", err)
+		return 1
+	}
+	if res == nil {
+		return 0
+	}
+	if err := printJSON(stdout, res); err != nil {
+		fmt.Fprintf(stderr, "fabctl: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func usage(fs *flag.FlagSet) {
+	w := fs.Output()
+	fmt.Fprintln(w, "usage: fabctl [flags] command [arguments]")
+	fmt.Fprintln(w, "\ncommands:")
+	names := make([]string, 0, len(commands))
+	for n := range commands {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		fmt.Fprintf(w, "  %s\n", commands[n].usage)
+	}
+	fmt.Fprintln(w, "\nflags:")
+	fs.PrintDefaults()
+}
+
+// env is the environment shared by the commands.
+type env struct {
+	cfgFile   string
+	path      string
+	user      string
+	walletDir string
+	stdin     io.Reader
+	stdout    io.Writer
+	stderr    io.Writer
+
+	cfg *blockchain.Configuration
+	c   *blockchain.Client
+}
+
+// config returns the configuration, loaded at first use.
+func (e *env) config() (*blockchain.Configuration, error) {
+	if e.cfg == nil {
+		cfg, err := blockchain.LoadConfiguration(e.cfgFile, e.path)
+		if err != nil {
+			return nil, err
+		}
+		if e.user != "" {
+			cfg.User = e.user
+		}
+		e.cfg = cfg
+	}
+	return e.cfg, nil
+}
+
+// client returns the Client, connected at first use.
+func (e *env) client() (*blockchain.Client, error) {
+	if e.c == nil {
+		var opts []blockchain.ClientOption
+		if e.user != "" {
+			opts = append(opts, blockchain.WithUser(e.user))
+		}
+		if e.walletDir != "" {
+			opts = append(opts, blockchain.WithWallet(e.walletDir))
+		}
+		c, err := blockchain.NewClient(e.cfgFile, e.path, opts...)
+		if err != nil {
+			return nil, err
+		}
+		e.c = c
+	}
+	return e.c, nil
+}
+
+func (e *env) close() {
+	if e.c != nil {
+		e.c.Close()
+		e.c = nil
+	}
+}
+
+// flagSet returns the flag set of the subcommand `name` of usage `use`.
+func (e *env) flagSet(name string, use string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(e.stderr, "usage: fabctl %s\n", use)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// subcommand dispatches `args` to the subcommand of `subs`.
+func (e *env) subcommand(name string, args []string,
+	subs map[string]func(e *env, args []string) (interface{}, error)) (interface{}, error) {
+	names := make([]string, 0, len(subs))
+	for n := range subs {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	if len(args) == 0 {
+		fmt.Fprintf(e.stderr, "usage: fabctl %s %s\n", name, strings.Join(names, "|"))
+		return nil, errUsage
+	}
+	sub, ok := subs[args[0]]
+	if !ok {
+		fmt.Fprintf(e.stderr, "fabctl: unknown command %q\nusage: fabctl %s %s\n", name+" "+args[0],
+			name, strings.Join(names, "|"))
+		return nil, errUsage
+	}
+	return sub(e, args[1:])
+}
+
+// printJSON prints `v` indented.
+func printJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// payload returns the chaincode response `res` as a JSON value: as is if it
+// is JSON, else as a string.
+func payload(res []byte) interface{} {
+	if len(res) == 0 {
+		return nil
+	}
+	if json.Valid(res) {
+		return json.RawMessage(res)
+	}
+	return string(res)
+}
@@ -0,0 +1,143 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"blockchain"
+	"github.com/stretchr/testify/require"
+)
+
+// testDir returns a configuration directory with the configuration "app", the
+// connection profile and the wallet of user1 of the test data.
+func testDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "fabctl")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	conf := filepath.Join("..", "..", "testdata", "conf")
+	copyFile(t, filepath.Join(conf, "connection-org1.yaml"), filepath.Join(dir, "connection-org1.yaml"))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "wallet"), 0755))
+	copyFile(t, filepath.Join(conf, "wallet", "user1.id"), filepath.Join(dir, "wallet", "user1.id"))
+	toml := "[gateway]\nConnection = \"connection-org1.yaml\"\nUser = \"user1\"\nUserPwd = \"user1pw\"\n" +
+		"ChannelID = \"mychannel\"\nChaincodeID = \"fabcar\"\ndir = \"" + filepath.ToSlash(dir) + "\"\n"
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "app.toml"), []byte(toml), 0644))
+	return dir
+}
+
+func copyFile(t *testing.T, from string, to string) {
+	data, err := ioutil.ReadFile(from)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(to, data, 0600))
+}
+
+// runTest runs fabctl with the configuration of `dir` and returns its exit
+// code, stdout and stderr.
+func runTest(dir string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	args = append([]string{"-config", "app", "-path", dir}, args...)
+	code := run(args, strings.NewReader(""), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func Test_run_Usage(t *testing.T) {
+	dir := testDir(t)
+	for _, args := range [][]string{nil, {"unknown"}, {"wallet"}, {"wallet", "drop"},
+		{"invoke"}, {"ledger", "block", "1", "2"}, {"wallet", "import", "user2"},
+		{"serve"}, {"serve", "-auth", "auth.json", "-cert", "server.pem"}} {
+		code, _, stderr := runTest(dir, args...)
+		require.Equal(t, 2, code, args)
+		require.Contains(t, stderr, "usage: fabctl", args)
+	}
+}
+
+func Test_run_Config(t *testing.T) {
+	require := require.New(t)
+	dir := testDir(t)
+
+	code, out, _ := runTest(dir, "config", "validate")
+	require.Equal(0, code)
+	require.JSONEq(`{"valid": true}`, out)
+
+	code, out, _ = runTest(dir, "config", "show")
+	require.Equal(0, code)
+	var v map[string]interface{}
+	require.NoError(json.Unmarshal([]byte(out), &v))
+	require.Equal("mychannel", v["channel"])
+	require.Equal("fabcar", v["chaincode"])
+	require.Equal("user1", v["user"])
+	require.Equal("***", v["userPwd"])
+	require.Equal(filepath.Join(dir, "wallet"), v["wallet"])
+	require.NotContains(out, "user1pw")
+
+	code, out, stderr := runTest(dir, "-user", "nobody", "config", "validate")
+	require.Equal(1, code)
+	require.Contains(out, "user nobody not in the wallet")
+	require.Contains(stderr, "invalid configuration")
+}
+
+func Test_run_Wallet(t *testing.T) {
+	require := require.New(t)
+	dir := testDir(t)
+
+	code, out, _ := runTest(dir, "wallet", "list")
+	require.Equal(0, code)
+	var entries []walletEntry
+	require.NoError(json.Unmarshal([]byte(out), &entries))
+	require.Len(entries, 1)
+	require.Equal("user1", entries[0].Label)
+	require.Equal("Org1MSP", entries[0].MspID)
+	require.Contains(entries[0].Subject, "CN=user1")
+
+	idFile := filepath.Join(dir, "user1.json")
+	code, _, _ = runTest(dir, "wallet", "export", "-out", idFile, "user1")
+	require.Equal(0, code)
+	code, out, _ = runTest(dir, "wallet", "import", "user2", idFile)
+	require.Equal(0, code)
+	require.Contains(out, `"label": "user2"`)
+	code, _, stderr := runTest(dir, "wallet", "import", "user2", idFile)
+	require.Equal(1, code)
+	require.Contains(stderr, errExists.Error())
+
+	code, out, _ = runTest(dir, "wallet", "export", "user2")
+	require.Equal(0, code)
+	require.Contains(out, "PRIVATE KEY")
+
+	code, _, _ = runTest(dir, "wallet", "remove", "user2")
+	require.Equal(0, code)
+	code, _, stderr = runTest(dir, "wallet", "remove", "user2")
+	require.Equal(1, code)
+	require.Contains(stderr, "not in the wallet")
+}
+
+func Test_loadAuth(t *testing.T) {
+	require := require.New(t)
+	dir := testDir(t)
+
+	file := filepath.Join(dir, "auth.json")
+	require.NoError(ioutil.WriteFile(file, []byte(`{"apiKeys": {"k1": "user1"}}`), 0600))
+	auth, err := loadAuth(file)
+	require.NoError(err)
+	require.Equal(map[string]string{"k1": "user1"}, auth.APIKeys)
+
+	require.NoError(ioutil.WriteFile(file, []byte(`{}`), 0600))
+	_, err = loadAuth(file)
+	require.ErrorIs(err, blockchain.ErrNoCredentials)
+	code, _, stderr := runTest(dir, "serve", "-auth", file)
+	require.Equal(1, code)
+	require.Contains(stderr, "invalid auth file")
+}
+
+func Test_payload(t *testing.T) {
+	require.Nil(t, payload(nil))
+	require.Equal(t, json.RawMessage(`{"a":1}`), payload([]byte(`{"a":1}`)))
+	require.Equal(t, "CAR1", payload([]byte("CAR1")))
+}
@@ -0,0 +1,163 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"blockchain"
+	"blockchain/ledgerpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const serveUsage = "serve -auth file [-addr :8080] [-cert file -key file [-ca file]] [-grpc addr] [-timeout d]"
+
+// serveAuth is the content of the file of flag -auth.  It maps the API keys and
+// the common names of the client certificates to the users of the wallet.
+type serveAuth struct {
+	APIKeys   map[string]string `json:"apiKeys"`
+	CertUsers map[string]string `json:"certUsers"`
+}
+
+func runServe(e *env, args []string) (interface{}, error) {
+	fs := e.flagSet("serve", serveUsage)
+	addr := fs.String("addr", ":8080", "listening address")
+	authFile := fs.String("auth", "", "JSON file mapping the callers to the users of the wallet")
+	certFile := fs.String("cert", "", "TLS certificate of the server")
+	keyFile := fs.String("key", "", "TLS private key of the server")
+	caFile := fs.String("ca", "", "CA certificates verifying the client certificates")
+	grpcAddr := fs.String("grpc", "", "listening address of the gRPC service Ledger")
+	timeout := fs.Duration("timeout", blockchain.DefaultRequestTimeout, "timeout of the calls")
+	if err := fs.Parse(args); err != nil {
+		return nil, errUsage
+	}
+	if fs.NArg() != 0 || *authFile == "" || (*certFile == "") != (*keyFile == "") || (*caFile != "" && *certFile == "") {
+		fs.Usage()
+		return nil, errUsage
+	}
+	auth, err := loadAuth(*authFile)
+	if err != nil {
+		return nil, err
+	}
+	hs := &http.Server{Addr: *addr}
+	if *caFile != "" {
+		data, err := ioutil.ReadFile(*caFile)
+		if err != nil {
+			return nil, err
+		}
+		cas := x509.NewCertPool()
+		if !cas.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificate in %s", *caFile)
+		}
+		// the callers without certificate may still use an API key.
+		hs.TLSConfig = &tls.Config{ClientCAs: cas, ClientAuth: tls.VerifyClientCertIfGiven}
+	}
+	var gs *grpc.Server
+	if *grpcAddr != "" {
+		var opts []grpc.ServerOption
+		if *certFile != "" {
+			cert, err := tls.LoadX509KeyPair(*certFile, *keyFile)
+			if err != nil {
+				return nil, err
+			}
+			// the gRPC callers authenticate as the REST ones.
+			cfg := &tls.Config{}
+			if hs.TLSConfig != nil {
+				cfg = hs.TLSConfig.Clone()
+			}
+			cfg.Certificates = []tls.Certificate{cert}
+			opts = append(opts, grpc.Creds(credentials.NewTLS(cfg)))
+		}
+		gs = grpc.NewServer(opts...)
+	}
+
+	var opts []blockchain.PoolOption
+	if e.walletDir != "" {
+		opts = append(opts, blockchain.WithClientOptions(blockchain.WithWallet(e.walletDir)))
+	}
+	pool, err := blockchain.NewClientPool(e.cfgFile, e.path, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer pool.Close()
+	srvOpts := []blockchain.ServerOption{blockchain.WithAPIKeys(auth.APIKeys),
+		blockchain.WithCertUsers(auth.CertUsers), blockchain.WithRequestTimeout(*timeout)}
+	srv, err := blockchain.NewServer(pool, srvOpts...)
+	if err != nil {
+		return nil, err
+	}
+	hs.Handler = srv
+	if gs != nil {
+		ls, err := blockchain.NewLedgerService(pool, srvOpts...)
+		if err != nil {
+			return nil, err
+		}
+		ledgerpb.RegisterLedgerServer(gs, ls)
+	}
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(stop)
+	errc := make(chan error, 2)
+	if gs != nil {
+		lis, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			return nil, err
+		}
+		go func() { errc <- gs.Serve(lis) }()
+		defer gs.Stop()
+		fmt.Fprintf(e.stderr, "fabctl: serving gRPC on %s\n", *grpcAddr)
+	}
+	go func() {
+		if *certFile != "" {
+			errc <- hs.ListenAndServeTLS(*certFile, *keyFile)
+			return
+		}
+		errc <- hs.ListenAndServe()
+	}()
+	fmt.Fprintf(e.stderr, "fabctl: serving on %s\n", *addr)
+	select {
+	case err := <-errc:
+		return nil, err
+	case <-stop:
+	}
+	// the event streams end with the Clients when the pool closes.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := hs.Shutdown(ctx); err != nil && !errors.Is(err, context.DeadlineExceeded) {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// loadAuth reads the file of flag -auth.
+func loadAuth(file string) (*serveAuth, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	auth := &serveAuth{}
+	if err := json.Unmarshal(data, auth); err != nil {
+		return nil, fmt.Errorf("invalid auth file %s: %v", file, err)
+	}
+	if len(auth.APIKeys) == 0 && len(auth.CertUsers) == 0 {
+		return nil, fmt.Errorf("invalid auth file %s: %w", file, blockchain.ErrNoCredentials)
+	}
+	return auth, nil
+}
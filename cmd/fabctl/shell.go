@@ -0,0 +1,421 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"blockchain"
+	"github.com/peterh/liner"
+)
+
+// shellHelp is printed by the command "help" of the shell.
+const shellHelp = `commands:
+  fn [args...]             submits or evaluates fn as tagged by the metadata
+  invoke fn [args...]      submits fn (alias submit)
+  query fn [args...]       evaluates fn (alias evaluate)
+  functions                lists the functions of the chaincode
+  use user|channel|chaincode|contract name
+                           switches the user, channel, chaincode or contract
+  status                   prints the current user, channel, chaincode and contract
+  help                     prints this help
+  exit                     leaves the shell (alias quit, ^D)
+Arguments are separated by spaces; quote them with '' or "" to keep spaces.`
+
+var errExit = errors.New("exit")
+
+// lineReader reads the lines typed in the shell.
+type lineReader interface {
+	Prompt(prompt string) (string, error)
+	AppendHistory(item string)
+}
+
+// shell is an interactive session on a chaincode.
+type shell struct {
+	e         *env
+	out       io.Writer
+	channel   string
+	chaincode string
+	contract  string // contract of a multi-contract chaincode, may be empty
+
+	ct *blockchain.Contract
+	md *blockchain.Metadata // nil if the chaincode has no metadata
+}
+
+func runShell(e *env, args []string) (interface{}, error) {
+	fs := e.flagSet("shell", "shell [-history file]")
+	home, _ := os.UserHomeDir()
+	history := fs.String("history", filepath.Join(home, ".fabctl_history"), "history file")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 {
+		if err == nil {
+			fs.Usage()
+		}
+		return nil, errUsage
+	}
+	cfg, err := e.config()
+	if err != nil {
+		return nil, err
+	}
+	sh := &shell{e: e, out: e.stdout, channel: cfg.ChannelID, chaincode: cfg.ChainCodeID,
+		contract: cfg.ContractName}
+	if err := sh.connect(); err != nil {
+		return nil, err
+	}
+
+	ln := liner.NewLiner()
+	defer ln.Close()
+	ln.SetCtrlCAborts(true)
+	ln.SetTabCompletionStyle(liner.TabPrints)
+	ln.SetCompleter(sh.complete)
+	if f, err := os.Open(*history); err == nil {
+		ln.ReadHistory(f)
+		f.Close()
+	}
+	defer func() {
+		if f, err := os.OpenFile(*history, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600); err == nil {
+			ln.WriteHistory(f)
+			f.Close()
+		}
+	}()
+	fmt.Fprintln(sh.out, `fabctl shell, "help" lists the commands`)
+	return nil, sh.loop(ln)
+}
+
+// loop executes the lines read from `lr` until exit or end of input.
+func (sh *shell) loop(lr lineReader) error {
+	for {
+		line, err := lr.Prompt(sh.prompt())
+		if err == io.EOF || err == liner.ErrPromptAborted {
+			fmt.Fprintln(sh.out)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		lr.AppendHistory(line)
+		err = sh.exec(line)
+		if err == errExit {
+			return nil
+		}
+		if err != nil {
+			fmt.Fprintf(sh.out, "error: %v\n", err)
+		}
+	}
+}
+
+func (sh *shell) prompt() string {
+	p := sh.user() + "@" + sh.channel + "/" + sh.chaincode
+	if sh.contract != "" {
+		p += ":" + sh.contract
+	}
+	return p + "> "
+}
+
+// user returns the current user.
+func (sh *shell) user() string {
+	if sh.e.user != "" {
+		return sh.e.user
+	}
+	if cfg, err := sh.e.config(); err == nil {
+		return cfg.User
+	}
+	return ""
+}
+
+// exec executes the command `line`.
+func (sh *shell) exec(line string) error {
+	args, err := splitArgs(line)
+	if err != nil {
+		return err
+	}
+	switch args[0] {
+	case "exit", "quit":
+		return errExit
+	case "help":
+		fmt.Fprintln(sh.out, shellHelp)
+		return nil
+	case "status":
+		return printJSON(sh.out, map[string]string{"user": sh.user(), "channel": sh.channel,
+			"chaincode": sh.chaincode, "contract": sh.contract})
+	case "functions":
+		for _, f := range sh.functions() {
+			fmt.Fprintln(sh.out, f)
+		}
+		return nil
+	case "use":
+		if len(args) != 3 {
+			return errors.New("usage: use user|channel|chaincode|contract name")
+		}
+		return sh.use(args[1], args[2])
+	case "invoke", "submit":
+		if len(args) < 2 {
+			return errors.New("usage: invoke fn [args...]")
+		}
+		return sh.call(true, args[1], args[2:])
+	case "query", "evaluate":
+		if len(args) < 2 {
+			return errors.New("usage: query fn [args...]")
+		}
+		return sh.call(false, args[1], args[2:])
+	}
+	submit := true
+	if sh.md != nil {
+		_, tx := sh.md.Transaction(sh.qualified(args[0]))
+		if tx == nil {
+			return fmt.Errorf("unknown function %s, use invoke or query to force the call", args[0])
+		}
+		submit = tx.IsSubmit()
+	}
+	return sh.call(submit, args[0], args[1:])
+}
+
+// call submits, or evaluates, `fn` and prints its pretty-printed response.
+func (sh *shell) call(submit bool, fn string, args []string) error {
+	var res []byte
+	var err error
+	if submit {
+		res, err = sh.ct.Invoke(fn, args...)
+	} else {
+		res, err = sh.ct.Query(fn, args...)
+	}
+	if err != nil {
+		return err
+	}
+	sh.print(res)
+	return nil
+}
+
+// print prints the response `res`, indented if it is JSON.
+func (sh *shell) print(res []byte) {
+	if len(res) == 0 {
+		fmt.Fprintln(sh.out, "(no response)")
+		return
+	}
+	var buf bytes.Buffer
+	if json.Indent(&buf, res, "", "  ") == nil {
+		fmt.Fprintln(sh.out, buf.String())
+		return
+	}
+	fmt.Fprintln(sh.out, string(res))
+}
+
+// use switches the `what` of the shell to `name`.  The shell is unchanged if
+// the switch fails.
+func (sh *shell) use(what string, name string) error {
+	user, channel, chaincode, contract := sh.e.user, sh.channel, sh.chaincode, sh.contract
+	switch what {
+	case "user":
+		sh.e.user = name
+		sh.e.close()
+	case "channel":
+		sh.channel = name
+	case "chaincode":
+		sh.chaincode, sh.contract = name, ""
+	case "contract":
+		sh.contract = name
+	default:
+		return fmt.Errorf("cannot use %s", what)
+	}
+	err := sh.connect()
+	if err == nil {
+		return nil
+	}
+	sh.channel, sh.chaincode, sh.contract = channel, chaincode, contract
+	if what == "user" {
+		// reconnects the previous user.
+		sh.e.user = user
+		sh.e.close()
+		if err1 := sh.connect(); err1 != nil {
+			return err1
+		}
+	}
+	return err
+}
+
+// connect creates the contract handle and loads the metadata of the shell.
+func (sh *shell) connect() error {
+	c, err := sh.e.client()
+	if err != nil {
+		return err
+	}
+	var contract []string
+	if sh.contract != "" {
+		contract = append(contract, sh.contract)
+	}
+	ct, err := c.Contract(sh.channel, sh.chaincode, contract...)
+	if err != nil {
+		return err
+	}
+	// the metadata are served by the system contract, not by a named contract.
+	mdc, err := c.Contract(sh.channel, sh.chaincode)
+	if err != nil {
+		return err
+	}
+	sh.ct, sh.md = ct, nil
+	data, err := mdc.Query(blockchain.MetadataFunction)
+	if err != nil {
+		fmt.Fprintf(sh.out, "no metadata, completion disabled: %v\n", err)
+		return nil
+	}
+	if sh.md, err = blockchain.ParseMetadata(data); err != nil {
+		fmt.Fprintf(sh.out, "invalid metadata, completion disabled: %v\n", err)
+	}
+	return nil
+}
+
+// qualified returns the name of `fn` in the metadata.
+func (sh *shell) qualified(fn string) string {
+	if sh.contract != "" && !strings.Contains(fn, ":") {
+		return sh.contract + ":" + fn
+	}
+	return fn
+}
+
+// functions returns the names of the functions callable in the shell, with
+// their parameters.
+func (sh *shell) functions() []string {
+	var fns []string
+	for _, name := range sh.functionNames() {
+		_, tx := sh.md.Transaction(sh.qualified(name))
+		s := name
+		for _, p := range tx.Parameters {
+			s += " " + p.Name + ":" + p.Schema.TypeName()
+		}
+		if !tx.IsSubmit() {
+			s += "  (evaluate)"
+		}
+		fns = append(fns, s)
+	}
+	return fns
+}
+
+// functionNames returns the sorted names of the functions callable in the
+// shell.  The functions of the non default contracts are qualified.
+func (sh *shell) functionNames() []string {
+	if sh.md == nil {
+		return nil
+	}
+	// the unknown transaction "" returns the default contract.
+	def, _ := sh.md.Transaction("")
+	var names []string
+	for cname, ct := range sh.md.Contracts {
+		if cname == "org.hyperledger.fabric" {
+			continue
+		}
+		if sh.contract != "" && cname != sh.contract {
+			continue
+		}
+		for _, tx := range ct.Transactions {
+			if sh.contract != "" || ct == def {
+				names = append(names, tx.Name)
+			} else {
+				names = append(names, cname+":"+tx.Name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// complete returns the completions of `line`.
+func (sh *shell) complete(line string) []string {
+	words := strings.Fields(line)
+	if len(words) == 0 || strings.HasSuffix(line, " ") {
+		words = append(words, "")
+	}
+	prefix := strings.Join(words[:len(words)-1], " ")
+	if prefix != "" {
+		prefix += " "
+	}
+	last := words[len(words)-1]
+
+	var candidates []string
+	switch {
+	case len(words) == 1:
+		candidates = append([]string{"invoke", "submit", "query", "evaluate", "functions", "use",
+			"status", "help", "exit"}, sh.functionNames()...)
+	case len(words) == 2 && (words[0] == "invoke" || words[0] == "submit" || words[0] == "query" ||
+		words[0] == "evaluate"):
+		candidates = sh.functionNames()
+	case len(words) == 2 && words[0] == "use":
+		candidates = []string{"user", "channel", "chaincode", "contract"}
+	case len(words) == 3 && words[0] == "use" && words[1] == "user":
+		if w, err := sh.e.wallet(); err == nil {
+			candidates, _ = w.List()
+		}
+	case len(words) == 3 && words[0] == "use" && words[1] == "contract" && sh.md != nil:
+		for name := range sh.md.Contracts {
+			if name != "org.hyperledger.fabric" {
+				candidates = append(candidates, name)
+			}
+		}
+		sort.Strings(candidates)
+	}
+	var res []string
+	for _, c := range candidates {
+		if strings.HasPrefix(c, last) {
+			res = append(res, prefix+c)
+		}
+	}
+	return res
+}
+
+// splitArgs splits `line` in words separated by spaces.  Single and double
+// quotes group words; a backslash escapes the next character outside single
+// quotes.
+func splitArgs(line string) ([]string, error) {
+	var args []string
+	var cur strings.Builder
+	inWord := false
+	var quote rune
+	escaped := false
+	for _, r := range line {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inWord = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				cur.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t':
+			if inWord {
+				args = append(args, cur.String())
+				cur.Reset()
+				inWord = false
+			}
+		default:
+			cur.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, errors.New("unterminated quote or escape")
+	}
+	if inWord {
+		args = append(args, cur.String())
+	}
+	if len(args) == 0 {
+		return nil, errors.New("empty command")
+	}
+	return args, nil
+}
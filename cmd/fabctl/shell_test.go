@@ -0,0 +1,119 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"blockchain"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeReader returns its lines, then io.EOF.
+type fakeReader struct {
+	lines   []string
+	history []string
+}
+
+func (f *fakeReader) Prompt(string) (string, error) {
+	if len(f.lines) == 0 {
+		return "", io.EOF
+	}
+	l := f.lines[0]
+	f.lines = f.lines[1:]
+	return l, nil
+}
+
+func (f *fakeReader) AppendHistory(item string) { f.history = append(f.history, item) }
+
+// testShell returns a shell, not connected, with the metadata of the test data.
+func testShell(t *testing.T) (*shell, *bytes.Buffer) {
+	data, err := ioutil.ReadFile(filepath.Join("..", "..", "testdata", "metadata.json"))
+	require.NoError(t, err)
+	md, err := blockchain.ParseMetadata(data)
+	require.NoError(t, err)
+	var out bytes.Buffer
+	e := &env{cfgFile: "app", path: testDir(t), stdout: &out}
+	return &shell{e: e, out: &out, channel: "mychannel", chaincode: "fabcar", md: md}, &out
+}
+
+func Test_splitArgs(t *testing.T) {
+	require := require.New(t)
+	for line, exp := range map[string][]string{
+		"QueryCar CAR1":                {"QueryCar", "CAR1"},
+		"  CreateCar  CAR9 \t Audi  ":  {"CreateCar", "CAR9", "Audi"},
+		`ChangeCarOwner CAR1 "Jo Doe"`: {"ChangeCarOwner", "CAR1", "Jo Doe"},
+		`f '{"a": "b c"}' ''`:          {"f", `{"a": "b c"}`, ""},
+		`f a\ b \"c`:                   {"f", "a b", `"c`},
+	} {
+		args, err := splitArgs(line)
+		require.NoError(err, line)
+		require.Equal(exp, args, line)
+	}
+	for _, line := range []string{"", "   ", `f "a`, `f a\`} {
+		_, err := splitArgs(line)
+		require.Error(err, line)
+	}
+}
+
+func Test_shell_Complete(t *testing.T) {
+	require := require.New(t)
+	sh, _ := testShell(t)
+
+	require.Equal([]string{"AuditContract:Record", "ChangeCarOwner", "CountCars", "CreateCar",
+		"QueryAllCars", "QueryCar"}, sh.functionNames())
+	require.Equal([]string{"QueryAllCars", "QueryCar"}, sh.complete("Que"))
+	require.Equal([]string{"query QueryAllCars", "query QueryCar"}, sh.complete("query Que"))
+	require.Equal([]string{"use channel", "use chaincode", "use contract"}, sh.complete("use c"))
+	require.Equal([]string{"use contract AuditContract"}, sh.complete("use contract A"))
+	require.Equal([]string{"use user user1"}, sh.complete("use user "))
+	require.Empty(sh.complete("QueryCar CA"))
+
+	sh.contract = "AuditContract"
+	require.Equal([]string{"Record"}, sh.functionNames())
+	require.Equal([]string{"Record type:string car:Car score:number"}, sh.functions())
+	sh.md = nil
+	require.Nil(sh.functionNames())
+	require.Equal([]string{"help"}, sh.complete("he"))
+}
+
+func Test_shell_Loop(t *testing.T) {
+	require := require.New(t)
+	sh, out := testShell(t)
+
+	lr := &fakeReader{lines: []string{"help", "", "status", "functions", "use planet x", "Unknown",
+		"query", `"`}}
+	require.NoError(sh.loop(lr))
+	require.Equal([]string{"help", "status", "functions", "use planet x", "Unknown", "query", `"`},
+		lr.history)
+	s := out.String()
+	require.Contains(s, shellHelp)
+	require.Contains(s, `"user": "user1"`)
+	require.Contains(s, `"channel": "mychannel"`)
+	require.Contains(s, "QueryCar carNumber:string  (evaluate)")
+	require.Contains(s, "CreateCar carNumber:string")
+	require.Contains(s, "error: cannot use planet")
+	require.Contains(s, "error: unknown function Unknown")
+	require.Contains(s, "error: usage: query fn")
+	require.Contains(s, "error: unterminated quote")
+	require.Equal("user1@mychannel/fabcar> ", sh.prompt())
+
+	lr = &fakeReader{lines: []string{"exit", "help"}}
+	out.Reset()
+	require.NoError(sh.loop(lr))
+	require.Empty(out.String())
+}
+
+func Test_shell_Print(t *testing.T) {
+	sh, out := testShell(t)
+	sh.print([]byte(`{"make":"Audi","owner":"Jo"}`))
+	sh.print([]byte("CAR1"))
+	sh.print(nil)
+	require.Equal(t, "{\n  \"make\": \"Audi\",\n  \"owner\": \"Jo\"\n}\nCAR1\n(no response)\n", out.String())
+}
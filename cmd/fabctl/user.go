@@ -0,0 +1,114 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"blockchain"
+)
+
+func runUser(e *env, args []string) (interface{}, error) {
+	return e.subcommand("user", args, map[string]func(e *env, args []string) (interface{}, error){
+		"register": userRegister,
+		"enroll":   userEnroll,
+		"revoke":   userRevoke,
+	})
+}
+
+func userRegister(e *env, args []string) (interface{}, error) {
+	fs := e.flagSet("user register", "user register [flags] name")
+	r := blockchain.Registration{Attributes: make(map[string]string)}
+	fs.StringVar(&r.Type, "type", "client", "type of the identity")
+	fs.StringVar(&r.Affiliation, "affiliation", "", "affiliation of the identity")
+	fs.StringVar(&r.Secret, "secret", "", "enrollment secret (default generated by the CA)")
+	fs.IntVar(&r.MaxEnrollments, "max", 0, "number of enrollments allowed (default of the CA)")
+	var attrs stringList
+	fs.Var(&attrs, "attr", "attribute name=value of the certificates (repeatable)")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 {
+		if err == nil {
+			fs.Usage()
+		}
+		return nil, errUsage
+	}
+	for _, a := range attrs {
+		kv := strings.SplitN(a, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid attribute %q", a)
+		}
+		r.Attributes[kv[0]] = kv[1]
+	}
+	r.Name = fs.Arg(0)
+	c, err := e.client()
+	if err != nil {
+		return nil, err
+	}
+	secret, err := c.RegisterUser(r)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"name": r.Name, "secret": secret}, nil
+}
+
+func userEnroll(e *env, args []string) (interface{}, error) {
+	if len(args) < 1 || len(args) > 2 {
+		fmt.Fprintln(e.stderr, "usage: fabctl user enroll name [secret]")
+		fmt.Fprintln(e.stderr, "  the secret is read from stdin if absent")
+		return nil, errUsage
+	}
+	name := args[0]
+	var secret string
+	if len(args) == 2 {
+		secret = args[1]
+	} else {
+		sc := bufio.NewScanner(e.stdin)
+		if !sc.Scan() {
+			return nil, fmt.Errorf("no secret for %s", name)
+		}
+		secret = strings.TrimSpace(sc.Text())
+	}
+	c, err := e.client()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.EnrollUser(name, secret); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"name": name, "enrolled": true}, nil
+}
+
+func userRevoke(e *env, args []string) (interface{}, error) {
+	fs := e.flagSet("user revoke", "user revoke [-reason reason] name")
+	reason := fs.String("reason", "", "reason of the revocation")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 {
+		if err == nil {
+			fs.Usage()
+		}
+		return nil, errUsage
+	}
+	c, err := e.client()
+	if err != nil {
+		return nil, err
+	}
+	serials, err := c.RevokeUser(fs.Arg(0), *reason)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"name": fs.Arg(0), "revoked": serials}, nil
+}
+
+// stringList is a repeatable string flag.
+type stringList []string
+
+func (s *stringList) String() string {
+	return fmt.Sprint([]string(*s))
+}
+
+func (s *stringList) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
@@ -0,0 +1,183 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package main
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/gateway"
+)
+
+// walletEntry describes an identity of the wallet.
+type walletEntry struct {
+	Label   string    `json:"label"`
+	MspID   string    `json:"mspId"`
+	Subject string    `json:"subject,omitempty"`
+	Issuer  string    `json:"issuer,omitempty"`
+	Expires time.Time `json:"expires,omitempty"`
+}
+
+func runWallet(e *env, args []string) (interface{}, error) {
+	return e.subcommand("wallet", args, map[string]func(e *env, args []string) (interface{}, error){
+		"list":   walletList,
+		"import": walletImport,
+		"export": walletExport,
+		"remove": walletRemove,
+	})
+}
+
+// wallet opens the wallet of the configuration, or of the flag -wallet.
+func (e *env) wallet() (*gateway.Wallet, error) {
+	dir := e.walletDir
+	if dir == "" {
+		cfg, err := e.config()
+		if err != nil {
+			return nil, err
+		}
+		dir = cfg.WalletDir()
+	}
+	return gateway.NewFileSystemWallet(dir)
+}
+
+func walletList(e *env, args []string) (interface{}, error) {
+	if len(args) != 0 {
+		fmt.Fprintln(e.stderr, "usage: fabctl wallet list")
+		return nil, errUsage
+	}
+	w, err := e.wallet()
+	if err != nil {
+		return nil, err
+	}
+	labels, err := w.List()
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]walletEntry, 0, len(labels))
+	for _, l := range labels {
+		id, err := w.Get(l)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", l, err)
+		}
+		entries = append(entries, describe(l, id))
+	}
+	return entries, nil
+}
+
+// describe returns the description of identity `id` of label `label`.
+func describe(label string, id gateway.Identity) walletEntry {
+	we := walletEntry{Label: label}
+	x, ok := id.(*gateway.X509Identity)
+	if !ok {
+		return we
+	}
+	we.MspID = x.MspID
+	if b, _ := pem.Decode([]byte(x.Certificate())); b != nil {
+		if cert, err := x509.ParseCertificate(b.Bytes); err == nil {
+			we.Subject, we.Issuer, we.Expires = cert.Subject.String(), cert.Issuer.String(), cert.NotAfter
+		}
+	}
+	return we
+}
+
+func walletImport(e *env, args []string) (interface{}, error) {
+	const use = "wallet import [-cert file -key file -msp id] label [file]"
+	fs := e.flagSet("wallet import", use)
+	certFile := fs.String("cert", "", "PEM certificate of the identity")
+	keyFile := fs.String("key", "", "PEM private key of the identity")
+	mspID := fs.String("msp", "", "MSP ID of the identity")
+	if err := fs.Parse(args); err != nil {
+		return nil, errUsage
+	}
+	fromFile := *certFile == "" && *keyFile == ""
+	if (fromFile && fs.NArg() != 2) || (!fromFile && (fs.NArg() != 1 || *certFile == "" || *keyFile == "" || *mspID == "")) {
+		fs.Usage()
+		return nil, errUsage
+	}
+
+	var id *gateway.X509Identity
+	if fromFile {
+		data, err := ioutil.ReadFile(fs.Arg(1))
+		if err != nil {
+			return nil, err
+		}
+		id = &gateway.X509Identity{}
+		if err := json.Unmarshal(data, id); err != nil {
+			return nil, fmt.Errorf("invalid identity file %s: %v", fs.Arg(1), err)
+		}
+		if id.Certificate() == "" || id.Key() == "" || id.MspID == "" {
+			return nil, fmt.Errorf("invalid identity file %s: missing credentials", fs.Arg(1))
+		}
+	} else {
+		cert, err := ioutil.ReadFile(*certFile)
+		if err != nil {
+			return nil, err
+		}
+		key, err := ioutil.ReadFile(*keyFile)
+		if err != nil {
+			return nil, err
+		}
+		id = gateway.NewX509Identity(*mspID, string(cert), string(key))
+	}
+	w, err := e.wallet()
+	if err != nil {
+		return nil, err
+	}
+	label := fs.Arg(0)
+	if w.Exists(label) {
+		return nil, fmt.Errorf("%s: %w", label, errExists)
+	}
+	if err := w.Put(label, id); err != nil {
+		return nil, err
+	}
+	return describe(label, id), nil
+}
+
+func walletExport(e *env, args []string) (interface{}, error) {
+	fs := e.flagSet("wallet export", "wallet export [-out file] label")
+	out := fs.String("out", "", "output file (default stdout)")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 {
+		if err == nil {
+			fs.Usage()
+		}
+		return nil, errUsage
+	}
+	w, err := e.wallet()
+	if err != nil {
+		return nil, err
+	}
+	id, err := w.Get(fs.Arg(0))
+	if err != nil {
+		return nil, err
+	}
+	if *out == "" {
+		return id, nil
+	}
+	data, err := json.MarshalIndent(id, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	// the file holds the private key.
+	return nil, ioutil.WriteFile(*out, data, 0600)
+}
+
+func walletRemove(e *env, args []string) (interface{}, error) {
+	if len(args) != 1 {
+		fmt.Fprintln(e.stderr, "usage: fabctl wallet remove label")
+		return nil, errUsage
+	}
+	w, err := e.wallet()
+	if err != nil {
+		return nil, err
+	}
+	if !w.Exists(args[0]) {
+		return nil, fmt.Errorf("%s: identity not in the wallet", args[0])
+	}
+	return nil, w.Remove(args[0])
+}
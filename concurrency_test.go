@@ -0,0 +1,175 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+// These tests are meaningful with the race detector: go test -race
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeContract is a backend replacing gateway.Contract.  It echoes the function
+// name and counts the calls in flight.
+type fakeContract struct {
+	name     string
+	delay    time.Duration
+	inflight int32
+	calls    int32
+	err      error
+}
+
+// Name returns the name as the SDK does, i.e., the chaincode ID optionally
+// followed by ':' and the contract name.
+func (f *fakeContract) Name() string {
+	return f.name
+}
+
+func (f *fakeContract) SubmitTransaction(name string, args ...string) ([]byte, error) {
+	return f.call(name)
+}
+
+func (f *fakeContract) EvaluateTransaction(name string, args ...string) ([]byte, error) {
+	return f.call(name)
+}
+
+func (f *fakeContract) call(name string) ([]byte, error) {
+	atomic.AddInt32(&f.inflight, 1)
+	defer atomic.AddInt32(&f.inflight, -1)
+	atomic.AddInt32(&f.calls, 1)
+	time.Sleep(f.delay)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []byte(name), nil
+}
+
+// fakeClient returns an initialized Client using `f` as backend.
+func fakeClient(f *fakeContract) *Client {
+	if f.name == "" {
+		f.name = "fabcar"
+	}
+	return &Client{
+		initialized: true,
+		contract:    f,
+		cfg:         &Configuration{ChannelID: "mychannel", ChainCodeID: "fabcar", User: "user1"},
+	}
+}
+
+func Test_Client_Concurrent_InvokeQueryClose(t *testing.T) {
+	f := &fakeContract{delay: time.Millisecond}
+	c := fakeClient(f)
+
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			<-start
+			for j := 0; j < 20; j++ {
+				res, err := c.Invoke("createCar")
+				if err != nil {
+					assert.ErrorIs(t, err, ErrClientClosed)
+					return
+				}
+				assert.Equal(t, "createCar", string(res))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			<-start
+			for j := 0; j < 20; j++ {
+				var res string
+				err := c.QueryJSON("queryAllCars", &res)
+				if err != nil {
+					assert.ErrorIs(t, err, ErrClientClosed)
+					return
+				}
+				assert.Equal(t, "queryAllCars", res)
+			}
+		}()
+	}
+	close(start)
+	time.Sleep(5 * time.Millisecond)
+	var closers sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		closers.Add(1)
+		go func() {
+			defer closers.Done()
+			c.Close()
+			// Close returns once the calls in flight are over.
+			assert.Zero(t, atomic.LoadInt32(&f.inflight))
+		}()
+	}
+	closers.Wait()
+	wg.Wait()
+
+	_, err := c.Invoke("createCar")
+	require.ErrorIs(t, err, ErrClientClosed)
+	_, err = c.Query("queryAllCars")
+	require.ErrorIs(t, err, ErrClientClosed)
+	_, err = c.BlockHeight()
+	require.ErrorIs(t, err, ErrClientClosed)
+}
+
+func Test_Client_NotInitialized(t *testing.T) {
+	c := &Client{}
+	_, err := c.Invoke("createCar")
+	require.ErrorIs(t, err, ErrClientNotInitialized)
+	c.Close()
+}
+
+func Test_FabricSetup_Concurrent_InitUser(t *testing.T) {
+	var connects int32
+	fs := &FabricSetup{}
+	fs.connect = func(name string, secret ...string) (*channel.Client, *event.Client, error) {
+		atomic.AddInt32(&connects, 1)
+		if name == "bad" {
+			return nil, nil, ErrInitClient
+		}
+		time.Sleep(time.Millisecond)
+		return &channel.Client{}, &event.Client{}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 40; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("user%d", i%3)
+			if i%10 == 0 {
+				name = "bad"
+			}
+			err := fs.InitUser(name)
+			if name == "bad" {
+				assert.True(t, errors.Is(err, ErrInitClient))
+			} else {
+				assert.NoError(t, err)
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			user := fs.CurrentUser()
+			cl, ev := fs.ChannelClient()
+			if user != "" {
+				assert.NotNil(t, cl)
+				assert.NotNil(t, ev)
+			}
+		}()
+	}
+	wg.Wait()
+	require.NotEqual(t, "bad", fs.CurrentUser())
+	require.True(t, atomic.LoadInt32(&connects) > 0)
+}
@@ -1,4 +1,4 @@
-// v0.2.3
+// v0.8.1
 // Author: DIEHL E.
 // (C) Sony Pictures Entertainment, Nov 2020
 
@@ -29,6 +29,10 @@ type Configuration struct {
 	ChannelID string
 	// ChainCodeID is the name of the chaincode within the channel.  Mandatory for fulll
 	ChainCodeID string
+	// ContractName is the name of the contract within a multi-contract chaincode.
+	// The functions are then invoked as "ContractName:fn".  It is the field
+	// "ContractName" of [gateway].  Optional
+	ContractName string
 	// ChannelConfig is the path to the channel TX descriptor.  Mandatory for full
 	ChannelConfig string
 	// ChaincodePath is the path to the chaincode to be packaged
@@ -55,11 +59,48 @@ type Configuration struct {
 	// gatewayNotLocal is false if the gateway interacts without a local docker. It is
 	// the field "notlocal".
 	gatewayNotLocal bool
+	// Contracts lists the named contracts reachable through the gateway.  It is
+	// the array of tables [[gateway.contracts]].
+	Contracts []ContractConfig
+	// Retry is the retry policy of the Client.  It is the section [retry].
+	Retry RetryPolicy
+	// Breaker is the circuit breaker policy of the Client.  It is the section
+	// [breaker].
+	Breaker BreakerPolicy
+	// Health defines the probe of Client.Health.  It is the section [health].
+	Health HealthPolicy
+	// SubmitLimit and EvaluateLimit are the rate limits of the submitted and
+	// evaluated transactions.  They are the sections [ratelimit.submit] and
+	// [ratelimit.evaluate].
+	SubmitLimit   RateLimit
+	EvaluateLimit RateLimit
+	// Log defines the package logger.  It is the section [log].
+	Log LogConfig
 
 	sdkDefined     bool
 	gatewayDefined bool
 }
 
+// LoadConfiguration reads the configuration file `configFile` from directory
+// `path` without connecting to the network.
+func LoadConfiguration(configFile string, path string) (*Configuration, error) {
+	vi := viper.New()
+	vi.SetConfigName(configFile)
+	vi.AddConfigPath(path)
+
+	cp := &Configuration{}
+	if err := cp.Load(vi); err != nil && err != ErrNoVault {
+		return nil, err
+	}
+	return cp, nil
+}
+
+// WalletDir returns the default directory of the wallet, i.e., the directory
+// "wallet" of the configuration directory.
+func (c *Configuration) WalletDir() string {
+	return filepath.Join(c.configDir, "wallet")
+}
+
 // Load retrieves the configuration information from the viper reader `vi`.
 func (c *Configuration) Load(vi *viper.Viper) error {
 	err := vi.ReadInConfig()
@@ -123,9 +164,15 @@ func (c *Configuration) Load(vi *viper.Viper) error {
 		c.ConnectionFile = filepath.Join(c.configDir, cfg)
 		c.User = vi.GetString("gateway.User")
 		c.UserPwd = vi.GetString("gateway.UserPwd")
+		RedactSecret(c.UserPwd)
 		c.ChannelID = vi.GetString("gateway.ChannelID")
 		c.ChainCodeID = vi.GetString("gateway.ChaincodeID")
+		c.ContractName = vi.GetString("gateway.ContractName")
 		c.gatewayNotLocal = vi.GetBool("gateway.notlocal")
+		if err := vi.UnmarshalKey("gateway.contracts", &c.Contracts); err != nil {
+			Logr.Errorf("could not read gateway.contracts: %v", err)
+			return err
+		}
 	}
 
 	if c.sdkDefined {
@@ -179,9 +226,56 @@ func (c *Configuration) Load(vi *viper.Viper) error {
 
 		// return err
 	}
+
+	if err := loadRedaction(vi); err != nil {
+		return err
+	}
+	if err := loadSDKLog(vi); err != nil {
+		return err
+	}
+	lc, err := loadLogConfig(vi)
+	if err != nil {
+		return err
+	}
+	c.Log = lc
+	if vi.IsSet("log") {
+		if err := ConfigureLog(lc); err != nil {
+			return err
+		}
+	}
+	c.Retry = loadRetryPolicy(vi)
+	c.Breaker = loadBreakerPolicy(vi)
+	c.Health = loadHealthPolicy(vi)
+	c.SubmitLimit = loadRateLimit(vi, "submit")
+	c.EvaluateLimit = loadRateLimit(vi, "evaluate")
+
+	// the named contracts default to the channel and chaincode of the configuration.
+	for i := range c.Contracts {
+		if c.Contracts[i].ChannelID == "" {
+			c.Contracts[i].ChannelID = c.ChannelID
+		}
+		if c.Contracts[i].ChainCodeID == "" {
+			c.Contracts[i].ChainCodeID = c.ChainCodeID
+		}
+	}
 	return nil
 }
 
+// ContractConfig defines a contract reachable by the Client under the name `Name`.
+type ContractConfig struct {
+	// Name identifies the contract within the application.  Mandatory
+	Name string `mapstructure:"name"`
+	// ChannelID is the channel of the chaincode.  It defaults to the ChannelID of
+	// the configuration.
+	ChannelID string `mapstructure:"channel"`
+	// ChainCodeID is the name of the chaincode.  It defaults to the ChainCodeID of
+	// the configuration.
+	ChainCodeID string `mapstructure:"chaincode"`
+	// Contract is the name of the contract within a multi-contract chaincode.
+	// Optional
+	Contract string `mapstructure:"contract"`
+}
+
 // // selectConfig selects the proper config file depending on the url type.
 // // vi is the pointer to the viper that has been read.  Currently, it
 // // supports 0, 1, 2, 3, 4 and -1. -1 is for testing.
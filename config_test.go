@@ -0,0 +1,110 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/gateway"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+// loadTestConfig loads the TOML configuration `toml` whose gateway directory is
+// a temporary directory.
+func loadTestConfig(t *testing.T, toml string) *Configuration {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	toml = "[gateway]\nConnection = \"connection.yaml\"\nUser = \"user1\"\nChannelID = \"mychannel\"\n" +
+		"ChaincodeID = \"fabcar\"\ndir = \"" + filepath.ToSlash(dir) + "\"\n" + toml
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "app.toml"), []byte(toml), 0644))
+
+	vi := viper.New()
+	vi.SetConfigName("app")
+	vi.AddConfigPath(dir)
+	cp := &Configuration{}
+	require.NoError(t, cp.Load(vi))
+	return cp
+}
+
+func Test_Configuration_Load_Contracts(t *testing.T) {
+	cp := loadTestConfig(t, `
+ContractName = "SmartContract"
+
+[[gateway.contracts]]
+name = "cars"
+
+[[gateway.contracts]]
+name = "audit"
+channel = "auditchannel"
+chaincode = "audit"
+contract = "AuditContract"
+`)
+	require.Equal(t, "mychannel", cp.ChannelID)
+	require.Equal(t, "SmartContract", cp.ContractName)
+	require.Equal(t, []ContractConfig{
+		{Name: "cars", ChannelID: "mychannel", ChainCodeID: "fabcar"},
+		{Name: "audit", ChannelID: "auditchannel", ChainCodeID: "audit", Contract: "AuditContract"},
+	}, cp.Contracts)
+}
+
+func Test_Client_Contract_NotInitialized(t *testing.T) {
+	c := &Client{}
+	_, err := c.Contract("mychannel", "fabcar")
+	require.ErrorIs(t, err, ErrClientNotInitialized)
+	_, err = c.NamedContract("cars")
+	require.ErrorIs(t, err, ErrClientNotInitialized)
+	_, err = c.InvokeContract("AuditContract", "Record")
+	require.ErrorIs(t, err, ErrClientNotInitialized)
+	_, err = c.ChaincodeContract("AuditContract")
+	require.ErrorIs(t, err, ErrClientNotInitialized)
+}
+
+func Test_Client_ChaincodeContract(t *testing.T) {
+	require := require.New(t)
+
+	c := fakeClient(&fakeContract{name: "fabcar:AuditContract"})
+	c.cfg.ContractName = "AuditContract"
+	c.networks = map[string]*gateway.Network{"mychannel": {}}
+	// the system contract is not reachable through the named contract.
+	require.ErrorIs(checkFunction(c.contract, MetadataFunction), ErrQualifiedFunction)
+	ct, err := c.ChaincodeContract("")
+	require.NoError(err)
+	require.Equal("fabcar", ct.contract.Name())
+	require.NoError(checkFunction(ct.contract, MetadataFunction))
+	ct, err = c.ChaincodeContract("AuditContract")
+	require.NoError(err)
+	require.Equal("fabcar:AuditContract", ct.contract.Name())
+}
+
+func Test_checkContractName(t *testing.T) {
+	require.NoError(t, checkContractName("org.example.asset"))
+	require.ErrorIs(t, checkContractName("org:asset"), ErrInvalidContractName)
+	require.ErrorIs(t, checkContractName("my asset"), ErrInvalidContractName)
+}
+
+func Test_LoadConfiguration(t *testing.T) {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	toml := "[gateway]\nConnection = \"connection.yaml\"\nUser = \"user1\"\nChannelID = \"mychannel\"\n" +
+		"ChaincodeID = \"fabcar\"\ndir = \"" + filepath.ToSlash(dir) + "\"\n"
+	require.NoError(ioutil.WriteFile(filepath.Join(dir, "app.toml"), []byte(toml), 0644))
+
+	cp, err := LoadConfiguration("app", dir)
+	require.NoError(err)
+	require.Equal("user1", cp.User)
+	require.Equal(filepath.Join(dir, "connection.yaml"), cp.ConnectionFile)
+	require.Equal(filepath.Join(dir, "wallet"), cp.WalletDir())
+
+	_, err = LoadConfiguration("missing", dir)
+	require.Error(err)
+}
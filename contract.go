@@ -0,0 +1,157 @@
+// v0.2.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/gateway"
+)
+
+// Contract is a lightweight handle on a chaincode of a channel.  It shares the
+// gateway connection of the Client that created it.
+type Contract struct {
+	c         *Client
+	contract  transactor
+	channel   string
+	chaincode string
+	name      string
+}
+
+// Contract returns a handle on chaincode `chaincode` of channel `channel`.  The
+// optional `contractName` selects a contract of a multi-contract chaincode.  The
+// network of each channel is created once and cached by the Client.
+func (c *Client) Contract(channel string, chaincode string, contractName ...string) (*Contract, error) {
+	if !c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	network, err := c.getNetwork(channel)
+	if err != nil {
+		return nil, err
+	}
+	ct := &Contract{c: c, channel: channel, chaincode: chaincode}
+	if len(contractName) > 0 && contractName[0] != "" {
+		if err := checkContractName(contractName[0]); err != nil {
+			return nil, err
+		}
+		ct.name = contractName[0]
+		ct.contract = gatewayContract{network.GetContractWithName(chaincode, ct.name)}
+	} else {
+		ct.contract = gatewayContract{network.GetContract(chaincode)}
+	}
+	return ct, nil
+}
+
+// mainContract returns the handle on the contract of the configuration of the
+// Client.
+func (c *Client) mainContract() *Contract {
+	return &Contract{c: c, contract: c.contract, channel: c.cfg.ChannelID,
+		chaincode: c.cfg.ChainCodeID, name: c.cfg.ContractName}
+}
+
+// NamedContract returns the handle on the contract `name` defined in the
+// [[gateway.contracts]] section of the configuration file.  It returns
+// ErrUnknownContract if the name is not defined.
+func (c *Client) NamedContract(name string) (*Contract, error) {
+	if !c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	for _, cc := range c.cfg.Contracts {
+		if cc.Name == name {
+			return c.Contract(cc.ChannelID, cc.ChainCodeID, cc.Contract)
+		}
+	}
+	return nil, ErrUnknownContract
+}
+
+// Invoke submits the transaction `fn` with the arguments `args`.
+func (ct *Contract) Invoke(fn string, args ...string) ([]byte, error) {
+	return ct.InvokeContext(context.Background(), fn, args...)
+}
+
+// InvokeContext is the Contract version of Client.InvokeContext.
+func (ct *Contract) InvokeContext(ctx context.Context, fn string, args ...string) ([]byte, error) {
+	if !ct.c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	return ct.c.submit(ctx, ct, fn, args...)
+}
+
+// Query evaluates the transaction `fn` with the arguments `args`.
+func (ct *Contract) Query(fn string, args ...string) ([]byte, error) {
+	return ct.QueryContext(context.Background(), fn, args...)
+}
+
+// QueryContext is the Contract version of Client.QueryContext.
+func (ct *Contract) QueryContext(ctx context.Context, fn string, args ...string) ([]byte, error) {
+	if !ct.c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	return ct.c.evaluate(ctx, ct, fn, args...)
+}
+
+// QueryJSON is the Contract version of Client.QueryJSON.
+func (ct *Contract) QueryJSON(fn string, out interface{}, args ...interface{}) error {
+	return callJSON(ct.Query, fn, out, args)
+}
+
+// InvokeJSON is the Contract version of Client.InvokeJSON.
+func (ct *Contract) InvokeJSON(fn string, out interface{}, args ...interface{}) error {
+	return callJSON(ct.Invoke, fn, out, args)
+}
+
+// Channel returns the channel of the contract.
+func (ct *Contract) Channel() string {
+	return ct.channel
+}
+
+// Chaincode returns the chaincode of the contract.
+func (ct *Contract) Chaincode() string {
+	return ct.chaincode
+}
+
+// Name returns the name of the contract within its chaincode, or "" for the
+// default contract.
+func (ct *Contract) Name() string {
+	return ct.name
+}
+
+// checkContractName returns ErrInvalidContractName if `name` cannot be a
+// contract name, i.e., it holds the separator ':' or spaces.
+func checkContractName(name string) error {
+	if strings.ContainsRune(name, ':') || strings.IndexFunc(name, unicode.IsSpace) >= 0 {
+		return ErrInvalidContractName
+	}
+	return nil
+}
+
+// checkFunction returns ErrQualifiedFunction if `fn` is already prefixed by a
+// contract name whereas `contract` is a named contract.  The gateway would
+// prefix it a second time.
+func checkFunction(contract transactor, fn string) error {
+	if strings.ContainsRune(fn, ':') && strings.ContainsRune(contract.Name(), ':') {
+		Logr.Errorf("%s is qualified whereas contract %s is named", fn, contract.Name())
+		return ErrQualifiedFunction
+	}
+	return nil
+}
+
+// getNetwork returns the network of channel `channel`, creating it at first use.
+func (c *Client) getNetwork(channel string) (*gateway.Network, error) {
+	c.networksMu.Lock()
+	defer c.networksMu.Unlock()
+	if n, ok := c.networks[channel]; ok {
+		return n, nil
+	}
+	n, err := c.gw.GetNetwork(channel)
+	if err != nil {
+		Logr.Errorf("Failed to get network %s: %v", channel, err)
+		return nil, err
+	}
+	c.networks[channel] = n
+	return n, nil
+}
@@ -13,6 +13,33 @@ var (
 	// initialized.  This may happen if using a structure Client that was not created
 	// by NewClient.
 	ErrClientNotInitialized = errors.New("client is not initialized")
+	// ErrClientClosed occurs when the Client is invoked after being closed.
+	ErrClientClosed = errors.New("client is closed")
+	// ErrCircuitOpen occurs when the circuit breaker of the Client rejects a call
+	// after repeated failures of the peers.
+	ErrCircuitOpen = errors.New("circuit breaker is open")
+	// ErrRateLimited occurs when the Client sheds a call because too many calls
+	// are waiting for the rate limiter.
+	ErrRateLimited = errors.New("rate limit exceeded")
+	// ErrTxNotFound occurs when the ledger does not hold a transaction.
+	ErrTxNotFound = errors.New("transaction not found")
+	// ErrTxPending occurs when an idempotent submission was sent earlier but its
+	// commit is not yet known.
+	ErrTxPending = errors.New("transaction pending")
+	// ErrIdempotencyConflict occurs when an idempotency key is reused for a
+	// different transaction.
+	ErrIdempotencyConflict = errors.New("idempotency key used by another transaction")
+	// ErrNoIdempotencyStore occurs when an idempotent submission is requested from
+	// a Client without idempotency store.
+	ErrNoIdempotencyStore = errors.New("no idempotency store")
+	// ErrQueued occurs when the Outbox queued a transaction instead of submitting
+	// it.
+	ErrQueued = errors.New("transaction queued in outbox")
+	// ErrBatchFailed occurs when some transactions of a batch failed.
+	ErrBatchFailed = errors.New("batch transactions failed")
+	// ErrBatchAborted is the error of the transactions of a batch that were not
+	// submitted because a previous one failed.
+	ErrBatchAborted = errors.New("batch aborted")
 	// ErrCreateUser occurs when the blockchain could not invoke
 	// the creation of a user.
 	ErrCreateUser = errors.New("cannot create new user")
@@ -46,4 +73,28 @@ var (
 	// ErrUserAlreadyExist occurs when the user is already known and it is required
 	// to be created again.
 	ErrUserAlreadyExist = errors.New("user already exists")
+	// ErrInvalidBlock occurs when a block cannot be decoded.
+	ErrInvalidBlock = errors.New("invalid block")
+	// ErrNotIndexed occurs when the requested transaction is not in the index.
+	ErrNotIndexed = errors.New("transaction not indexed")
+	// ErrEventStreamClosed occurs when the peer closes the stream of events.
+	ErrEventStreamClosed = errors.New("event stream closed")
+	// ErrFunctionMismatch occurs when the chaincode does not expose the expected
+	// transactions.
+	ErrFunctionMismatch = errors.New("chaincode functions do not match")
+	// ErrUnknownContract occurs when requesting a named contract that the
+	// configuration file does not define.
+	ErrUnknownContract = errors.New("unknown contract")
+	// ErrInvalidContractName occurs when a contract name holds ':' or spaces.
+	ErrInvalidContractName = errors.New("invalid contract name")
+	// ErrQualifiedFunction occurs when invoking a function prefixed by a contract
+	// name through a named contract.
+	ErrQualifiedFunction = errors.New("function already qualified by a contract")
+	// ErrPoolFull occurs when all the Clients of a ClientPool are in use.
+	ErrPoolFull = errors.New("client pool is full")
+	// ErrPoolClosed occurs when using a ClientPool after its closure.
+	ErrPoolClosed = errors.New("client pool is closed")
+	// ErrNoCredentials occurs when creating a Server that maps no API key nor
+	// certificate to a wallet identity.
+	ErrNoCredentials = errors.New("server has no API key nor certificate user")
 )
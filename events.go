@@ -0,0 +1,117 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/event"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fab/events/deliverclient/seek"
+)
+
+type eventOptions struct {
+	from    uint64
+	hasFrom bool
+}
+
+// EventOption allows to parameterize the ChaincodeEvents function.
+type EventOption func(opts *eventOptions)
+
+// WithStartBlock delivers the events committed from block `n` onwards.  By
+// default, the stream starts with the newest block of the channel.
+func WithStartBlock(n uint64) EventOption {
+	return func(eo *eventOptions) {
+		eo.from, eo.hasFrom = n, true
+	}
+}
+
+// ChaincodeEvents streams the events of the chaincode of the Client whose name
+// matches the regular expression `filter`.  An empty filter matches every event.
+// The returned channel is closed when `ctx` is done, when the Client closes or
+// when the peer ends the stream.
+func (c *Client) ChaincodeEvents(ctx context.Context, filter string, opts ...EventOption) (<-chan *ChaincodeEvent, error) {
+	if !c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	return c.chaincodeEvents(ctx, c.mainContract(), filter, opts)
+}
+
+// ChaincodeEvents streams the events of the chaincode of the contract.  See
+// Client.ChaincodeEvents.
+func (ct *Contract) ChaincodeEvents(ctx context.Context, filter string, opts ...EventOption) (<-chan *ChaincodeEvent, error) {
+	if !ct.c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	return ct.c.chaincodeEvents(ctx, ct, filter, opts)
+}
+
+// chaincodeEvents registers to the chaincode events of `ct`.  The events are
+// delivered with full blocks so that they carry their payload.
+func (c *Client) chaincodeEvents(ctx context.Context, ct *Contract, filter string,
+	opts []EventOption) (<-chan *ChaincodeEvent, error) {
+	eo := eventOptions{}
+	for _, option := range opts {
+		option(&eo)
+	}
+	if filter == "" {
+		filter = ".*"
+	}
+	cp, err := c.channelContext(ct.channel)
+	if err != nil {
+		return nil, err
+	}
+	evOpts := []event.ClientOption{event.WithBlockEvents()}
+	if eo.hasFrom {
+		evOpts = append(evOpts, event.WithSeekType(seek.FromBlock), event.WithBlockNum(eo.from))
+	}
+	ec, err := event.New(cp, evOpts...)
+	if err != nil {
+		Logr.Errorf("could not create event client: %v", err)
+		return nil, err
+	}
+	reg, in, err := ec.RegisterChaincodeEvent(ct.chaincode, filter)
+	if err != nil {
+		Logr.Errorf("could not register chaincode events of %s: %v", ct.chaincode, err)
+		return nil, err
+	}
+	Logr.WithField(LogFieldChannel, ct.channel).WithField(LogFieldChaincode, ct.chaincode).
+		Debugf("streaming chaincode events %q", filter)
+	out := make(chan *ChaincodeEvent)
+	go func() {
+		defer ec.Unregister(reg)
+		forwardEvents(ctx, in, out, c.closingCh())
+	}()
+	return out, nil
+}
+
+// forwardEvents converts the events of `in` and sends them to `out` until `ctx`
+// is done, `closing` is closed or `in` is closed.  It closes `out`.
+func forwardEvents(ctx context.Context, in <-chan *fab.CCEvent, out chan<- *ChaincodeEvent,
+	closing <-chan struct{}) {
+	defer close(out)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-closing:
+			return
+		case ev, ok := <-in:
+			if !ok {
+				Logr.Warnf("chaincode events: %v", ErrEventStreamClosed)
+				return
+			}
+			ce := &ChaincodeEvent{Chaincode: ev.ChaincodeID, TxID: ev.TxID, Name: ev.EventName,
+				Payload: ev.Payload, BlockNumber: ev.BlockNumber}
+			select {
+			case out <- ce:
+			case <-ctx.Done():
+				return
+			case <-closing:
+				return
+			}
+		}
+	}
+}
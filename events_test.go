@@ -0,0 +1,62 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_forwardEvents(t *testing.T) {
+	require := require.New(t)
+
+	in := make(chan *fab.CCEvent, 2)
+	out := make(chan *ChaincodeEvent)
+	in <- &fab.CCEvent{TxID: "tx1", ChaincodeID: "fabcar", EventName: "CarCreated", Payload: []byte("CAR1"),
+		BlockNumber: 7}
+	close(in)
+	go forwardEvents(context.Background(), in, out, nil)
+	ev := <-out
+	require.Equal(&ChaincodeEvent{Chaincode: "fabcar", TxID: "tx1", Name: "CarCreated", Payload: []byte("CAR1"),
+		BlockNumber: 7}, ev)
+	_, ok := <-out
+	require.False(ok)
+
+	// stopped by the context, even if the event is not read.
+	in = make(chan *fab.CCEvent, 1)
+	in <- &fab.CCEvent{TxID: "tx2"}
+	out = make(chan *ChaincodeEvent)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		forwardEvents(ctx, in, out, nil)
+		close(done)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	<-done
+
+	// stopped by the closure of the Client.
+	closing := make(chan struct{})
+	out = make(chan *ChaincodeEvent)
+	go forwardEvents(context.Background(), make(chan *fab.CCEvent), out, closing)
+	close(closing)
+	_, ok = <-out
+	require.False(ok)
+}
+
+func Test_Client_ChaincodeEvents_Closed(t *testing.T) {
+	_, err := (&Client{}).ChaincodeEvents(context.Background(), "")
+	require.ErrorIs(t, err, ErrClientNotInitialized)
+
+	c := fakeClient(&fakeContract{})
+	c.Close()
+	_, err = c.ChaincodeEvents(context.Background(), "")
+	require.ErrorIs(t, err, ErrClientClosed)
+}
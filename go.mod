@@ -3,9 +3,20 @@ module blockchain
 go 1.15
 
 require (
+	github.com/golang/protobuf v1.3.3
+	github.com/hyperledger/fabric-protos-go v0.0.0-20200707132912-fee30f3ccd23
 	github.com/hyperledger/fabric-sdk-go v1.0.0-rc1
+	github.com/peterh/liner v1.2.1
+	github.com/pkg/errors v0.8.1
+	github.com/prometheus/client_golang v1.1.0
 	github.com/sirupsen/logrus v1.7.0
 	github.com/spf13/viper v1.7.1
 	github.com/stretchr/testify v1.7.0
+	go.etcd.io/bbolt v1.3.5
+	go.opentelemetry.io/otel v1.0.0
+	go.opentelemetry.io/otel/sdk v1.0.0
+	go.opentelemetry.io/otel/trace v1.0.0
 	golang.org/x/crypto v0.0.0-20200820211705-5c72a883971a // indirect
+	google.golang.org/grpc v1.29.1
+	gopkg.in/natefinch/lumberjack.v2 v2.0.0
 )
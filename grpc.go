@@ -0,0 +1,320 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"blockchain/ledgerpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	grpcmd "google.golang.org/grpc/metadata"
+	grpcpeer "google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// commitRetention is the duration the LedgerService keeps the outcome of an
+// asynchronous submission for CommitStatus.
+const commitRetention = 10 * time.Minute
+
+// LedgerService is the gRPC service ledgerpb.LedgerServer built on the Clients
+// of a ClientPool.  It maps the callers to the wallet identities, checks the
+// calls against the metadata of the chaincode and bounds the calls as Server
+// does.  The caller sends its API key in the metadata "x-api-key" or
+// "authorization: Bearer <key>".  The errors of the Client are returned with
+// the matching gRPC code, e.g., RESOURCE_EXHAUSTED for ErrRateLimited.
+//
+//	gs := grpc.NewServer()
+//	ledgerpb.RegisterLedgerServer(gs, ls)
+type LedgerService struct {
+	ledgerpb.UnimplementedLedgerServer
+	s *Server
+
+	mu      sync.Mutex
+	pending map[string]*SubmitHandle // asynchronous submissions by txID
+}
+
+// NewLedgerService returns a LedgerService using the Clients of `pool`.  It
+// accepts the options of NewServer.
+func NewLedgerService(pool *ClientPool, options ...ServerOption) (*LedgerService, error) {
+	s, err := NewServer(pool, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &LedgerService{s: s, pending: make(map[string]*SubmitHandle)}, nil
+}
+
+// Evaluate implements ledgerpb.LedgerServer.
+func (ls *LedgerService) Evaluate(ctx context.Context, req *ledgerpb.EvaluateRequest) (*ledgerpb.EvaluateResponse, error) {
+	resp := &ledgerpb.EvaluateResponse{}
+	err := ls.do(ctx, func(ctx context.Context, c *Client) error {
+		ct, err := ls.call(ctx, c, req.GetTarget(), req.GetFunction(), req.GetArgs())
+		if err != nil {
+			return err
+		}
+		resp.Result, err = ct.QueryContext(ctx, req.GetFunction(), req.GetArgs()...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Submit implements ledgerpb.LedgerServer.
+func (ls *LedgerService) Submit(ctx context.Context, req *ledgerpb.SubmitRequest) (*ledgerpb.SubmitResponse, error) {
+	resp := &ledgerpb.SubmitResponse{}
+	err := ls.do(ctx, func(ctx context.Context, c *Client) error {
+		h, err := ls.submit(ctx, c, req)
+		if err != nil {
+			return err
+		}
+		resp.TxId, resp.Result = h.TxID, h.Payload
+		st, err := h.Status(ctx)
+		if st != nil && !st.Valid {
+			return status.Errorf(codes.Aborted, "transaction %s is %s", h.TxID, st.ValidationCode)
+		}
+		if err != nil {
+			// the transaction may still commit: the caller asks CommitStatus.
+			return fmt.Errorf("transaction %s: %w", h.TxID, err)
+		}
+		resp.Status = txStatusPB(st)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SubmitAsync implements ledgerpb.LedgerServer.
+func (ls *LedgerService) SubmitAsync(ctx context.Context, req *ledgerpb.SubmitRequest) (*ledgerpb.SubmitAsyncResponse, error) {
+	resp := &ledgerpb.SubmitAsyncResponse{}
+	err := ls.do(ctx, func(ctx context.Context, c *Client) error {
+		h, err := ls.submit(ctx, c, req)
+		if err != nil {
+			return err
+		}
+		ls.remember(h)
+		resp.TxId, resp.Result = h.TxID, h.Payload
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CommitStatus implements ledgerpb.LedgerServer.
+func (ls *LedgerService) CommitStatus(ctx context.Context, req *ledgerpb.CommitStatusRequest) (*ledgerpb.TxStatus, error) {
+	if req.GetTxId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no transaction")
+	}
+	var st *TxStatus
+	err := ls.do(ctx, func(ctx context.Context, c *Client) error {
+		ls.mu.Lock()
+		h := ls.pending[req.GetTxId()]
+		ls.mu.Unlock()
+		var err error
+		if h == nil {
+			st, err = ls.s.txStatus(c, req.GetTxId())
+			return err
+		}
+		st, err = h.Status(ctx)
+		if st != nil {
+			// the status of an invalid transaction is not an error.
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return txStatusPB(st), nil
+}
+
+// ChaincodeEvents implements ledgerpb.LedgerServer.  The request timeout does
+// not apply.
+func (ls *LedgerService) ChaincodeEvents(req *ledgerpb.ChaincodeEventsRequest, stream ledgerpb.Ledger_ChaincodeEventsServer) error {
+	ctx := stream.Context()
+	user, err := ls.caller(ctx)
+	if err != nil {
+		return err
+	}
+	c, err := ls.s.pool.Get(user)
+	if err != nil {
+		return ls.fail(user, err)
+	}
+	defer ls.s.pool.Release(user)
+	t := req.GetTarget()
+	ct, err := ls.s.contract(c, t.GetChannel(), t.GetChaincode(), t.GetContract())
+	if err != nil {
+		return ls.fail(user, err)
+	}
+	var opts []EventOption
+	if req.GetStartBlock() > 0 {
+		opts = append(opts, WithStartBlock(req.GetStartBlock()))
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events, err := ls.s.events(ct, ctx, req.GetFilter(), opts...)
+	if err != nil {
+		return ls.fail(user, err)
+	}
+	for ev := range events {
+		err := stream.Send(&ledgerpb.ChaincodeEvent{Chaincode: ev.Chaincode, TxId: ev.TxID, Name: ev.Name,
+			Payload: ev.Payload, BlockNumber: ev.BlockNumber})
+		if err != nil {
+			return err
+		}
+	}
+	if ctx.Err() != nil {
+		return ls.fail(user, ctx.Err())
+	}
+	return ls.fail(user, ErrEventStreamClosed)
+}
+
+// do calls `fn` with the Client of the caller of `ctx` within the request
+// timeout.  It converts the error of `fn` into a gRPC status.
+func (ls *LedgerService) do(ctx context.Context, fn func(ctx context.Context, c *Client) error) error {
+	user, err := ls.caller(ctx)
+	if err != nil {
+		return err
+	}
+	c, err := ls.s.pool.Get(user)
+	if err != nil {
+		return ls.fail(user, err)
+	}
+	defer ls.s.pool.Release(user)
+	ctx, cancel := context.WithTimeout(ctx, ls.s.timeout)
+	defer cancel()
+	return ls.fail(user, fn(ctx, c))
+}
+
+// caller returns the wallet identity of the caller of `ctx`.
+func (ls *LedgerService) caller(ctx context.Context) (string, error) {
+	var key string
+	if md, ok := grpcmd.FromIncomingContext(ctx); ok {
+		key = apiKey(first(md.Get("x-api-key")), first(md.Get("authorization")))
+	}
+	var state *tls.ConnectionState
+	if p, ok := grpcpeer.FromContext(ctx); ok {
+		if ti, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			state = &ti.State
+		}
+	}
+	user, ok := ls.s.identify(key, state)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "unknown caller")
+	}
+	return user, nil
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// call returns the contract of the call of `fn` with the arguments `args`.  The
+// call is checked against the metadata of the chaincode, if any.
+func (ls *LedgerService) call(ctx context.Context, c *Client, t *ledgerpb.Target, fn string, args []string) (*Contract, error) {
+	if fn == "" {
+		return nil, badRequest("no function")
+	}
+	ct, err := ls.s.contract(c, t.GetChannel(), t.GetChaincode(), t.GetContract())
+	if err != nil {
+		return nil, err
+	}
+	md := ls.s.metadata(ctx, ct)
+	if md == nil {
+		return ct, nil
+	}
+	tx, err := lookupTx(md, ct, fn)
+	if err != nil {
+		return nil, err
+	}
+	// the arguments of the parameters that are not strings are JSON values.
+	raw := make([]json.RawMessage, len(args))
+	for i, a := range args {
+		raw[i] = json.RawMessage(a)
+		if i < len(tx.Parameters) && (tx.Parameters[i].Schema == nil || tx.Parameters[i].Schema.Type.Main() == "string") {
+			raw[i], _ = json.Marshal(a)
+		}
+	}
+	return ct, checkArgs(tx, fn, raw)
+}
+
+// submit sends the transaction of `req` without waiting for its commit.
+func (ls *LedgerService) submit(ctx context.Context, c *Client, req *ledgerpb.SubmitRequest) (*SubmitHandle, error) {
+	ct, err := ls.call(ctx, c, req.GetTarget(), req.GetFunction(), req.GetArgs())
+	if err != nil {
+		return nil, err
+	}
+	return ls.s.submitAsync(ct, ctx, req.GetFunction(), req.GetArgs()...)
+}
+
+// remember keeps the handle `h` for CommitStatus until commitRetention after
+// its outcome is known.
+func (ls *LedgerService) remember(h *SubmitHandle) {
+	ls.mu.Lock()
+	ls.pending[h.TxID] = h
+	ls.mu.Unlock()
+	go func() {
+		<-h.Done()
+		time.AfterFunc(commitRetention, func() {
+			ls.mu.Lock()
+			delete(ls.pending, h.TxID)
+			ls.mu.Unlock()
+		})
+	}()
+}
+
+// fail converts `err` of the call of `user` into a gRPC status with the code
+// matching the HTTP status of statusOf.
+func (ls *LedgerService) fail(user string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	code := codes.Unknown
+	switch statusOf(err) {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusTooManyRequests:
+		code = codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		code = codes.Unavailable
+	case http.StatusGatewayTimeout:
+		code = codes.DeadlineExceeded
+	default:
+		Logr.WithField(LogFieldUser, user).Warnf("grpc: %v", err)
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	case errors.Is(err, ErrEventStreamClosed):
+		code = codes.Unavailable
+	}
+	return status.Error(code, err.Error())
+}
+
+func txStatusPB(st *TxStatus) *ledgerpb.TxStatus {
+	return &ledgerpb.TxStatus{TxId: st.TxID, BlockNumber: st.BlockNumber, ValidationCode: st.ValidationCode,
+		Valid: st.Valid}
+}
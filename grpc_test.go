@@ -0,0 +1,247 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"blockchain/ledgerpb"
+
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testLedger returns a client of a LedgerService on the Server of testServer
+// and the context of the API key "k1".
+func testLedger(t *testing.T, code *peer.TxValidationCode) (ledgerpb.LedgerClient, *Server, context.Context) {
+	s, _ := testServer(t, code)
+	ls := &LedgerService{s: s, pending: make(map[string]*SubmitHandle)}
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer()
+	ledgerpb.RegisterLedgerServer(gs, ls)
+	go gs.Serve(lis)
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-api-key", "k1")
+	return ledgerpb.NewLedgerClient(conn), s, ctx
+}
+
+func Test_NewLedgerService(t *testing.T) {
+	_, err := NewLedgerService(nil)
+	require.ErrorIs(t, err, ErrNoCredentials)
+}
+
+func Test_LedgerService_Evaluate(t *testing.T) {
+	require := require.New(t)
+	lc, _, ctx := testLedger(t, nil)
+
+	resp, err := lc.Evaluate(ctx, &ledgerpb.EvaluateRequest{Function: "QueryCar", Args: []string{"CAR1"}})
+	require.NoError(err)
+	require.Equal("QueryCar", string(resp.Result))
+
+	for _, tc := range []struct {
+		fn   string
+		args []string
+		code codes.Code
+	}{
+		{"QueryCar", nil, codes.InvalidArgument},
+		{"", nil, codes.InvalidArgument},
+		{"Unknown", nil, codes.NotFound},
+		{"AuditContract:Record", []string{"check", `{"make": "VW"}`, "x"}, codes.InvalidArgument},
+	} {
+		_, err = lc.Evaluate(ctx, &ledgerpb.EvaluateRequest{Function: tc.fn, Args: tc.args})
+		require.Equal(tc.code, status.Code(err), tc.fn)
+	}
+	_, err = lc.Evaluate(ctx, &ledgerpb.EvaluateRequest{Function: "AuditContract:Record",
+		Args: []string{"check", `{"make": "VW"}`, "4.5"}})
+	require.NoError(err)
+
+	_, err = lc.Evaluate(context.Background(), &ledgerpb.EvaluateRequest{Function: "CountCars"})
+	require.Equal(codes.Unauthenticated, status.Code(err))
+	bearer := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer k1")
+	_, err = lc.Evaluate(bearer, &ledgerpb.EvaluateRequest{Function: "CountCars"})
+	require.NoError(err)
+}
+
+func Test_LedgerService_Submit(t *testing.T) {
+	require := require.New(t)
+	valid, mvcc := peer.TxValidationCode_VALID, peer.TxValidationCode_MVCC_READ_CONFLICT
+	lc, _, ctx := testLedger(t, &valid)
+
+	resp, err := lc.Submit(ctx, &ledgerpb.SubmitRequest{Function: "ChangeCarOwner", Args: []string{"CAR1", "Jo"}})
+	require.NoError(err)
+	require.Equal("tx1", resp.TxId)
+	require.Equal(`{"done":true}`, string(resp.Result))
+	require.Equal(&ledgerpb.TxStatus{TxId: "tx1", BlockNumber: 7, ValidationCode: "VALID", Valid: true},
+		resp.Status)
+
+	lc, _, ctx = testLedger(t, &mvcc)
+	_, err = lc.Submit(ctx, &ledgerpb.SubmitRequest{Function: "ChangeCarOwner", Args: []string{"CAR1", "Jo"}})
+	require.Equal(codes.Aborted, status.Code(err))
+	require.Contains(err.Error(), "tx1")
+
+	// never committed
+	lc, s, ctx := testLedger(t, nil)
+	s.timeout = 10 * time.Millisecond
+	_, err = lc.Submit(ctx, &ledgerpb.SubmitRequest{Function: "ChangeCarOwner", Args: []string{"CAR1", "Jo"}})
+	require.Equal(codes.DeadlineExceeded, status.Code(err))
+}
+
+func Test_LedgerService_ContractName(t *testing.T) {
+	require := require.New(t)
+	valid := peer.TxValidationCode_VALID
+	lc, s, ctx := testLedger(t, &valid)
+
+	// the SDK names the default contract after the chaincode.
+	c, err := s.pool.Get("user1")
+	require.NoError(err)
+	require.Equal("fabcar", c.contract.Name())
+	_, err = lc.Evaluate(ctx, &ledgerpb.EvaluateRequest{Function: "QueryCar", Args: []string{"CAR1"}})
+	require.NoError(err)
+	_, err = lc.SubmitAsync(ctx, &ledgerpb.SubmitRequest{Function: "ChangeCarOwner", Args: []string{"CAR1", "Jo"}})
+	require.NoError(err)
+
+	c.cfg.ContractName = "AuditContract"
+	c.contract = &fakeContract{name: "fabcar:AuditContract"}
+	s.pool.Release("user1")
+	args := []string{"check", `{"make": "VW"}`, "4.5"}
+	resp, err := lc.Evaluate(ctx, &ledgerpb.EvaluateRequest{Function: "Record", Args: args})
+	require.NoError(err)
+	require.Equal("Record", string(resp.Result))
+	_, err = lc.Submit(ctx, &ledgerpb.SubmitRequest{Function: "Record", Args: args})
+	require.NoError(err)
+	_, err = lc.Evaluate(ctx, &ledgerpb.EvaluateRequest{Function: "QueryCar", Args: []string{"CAR1"}})
+	require.Equal(codes.NotFound, status.Code(err))
+}
+
+// metadataContract serves the metadata `data`.
+type metadataContract struct {
+	*fakeContract
+	data []byte
+}
+
+func (f *metadataContract) EvaluateTransaction(name string, args ...string) ([]byte, error) {
+	res, err := f.fakeContract.EvaluateTransaction(name, args...)
+	if err == nil && name == MetadataFunction {
+		return f.data, nil
+	}
+	return res, err
+}
+
+func Test_LedgerService_Metadata(t *testing.T) {
+	require := require.New(t)
+	lc, s, ctx := testLedger(t, nil)
+	s.mds = map[string]mdEntry{}
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "metadata.json"))
+	require.NoError(err)
+	c, err := s.pool.Get("user1")
+	require.NoError(err)
+	f := &metadataContract{fakeContract: c.contract.(*fakeContract), data: data}
+	c.contract = f
+	s.pool.Release("user1")
+
+	// the calls are checked once the peer is back.
+	f.err = errors.New("rpc error: code = Unavailable desc = connection refused")
+	_, err = lc.Evaluate(ctx, &ledgerpb.EvaluateRequest{Function: "QueryCar"})
+	require.Equal(codes.Unknown, status.Code(err))
+	f.err = nil
+	_, err = lc.Evaluate(ctx, &ledgerpb.EvaluateRequest{Function: "QueryCar"})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func Test_LedgerService_CommitStatus(t *testing.T) {
+	require := require.New(t)
+	mvcc := peer.TxValidationCode_MVCC_READ_CONFLICT
+	lc, _, ctx := testLedger(t, &mvcc)
+
+	resp, err := lc.SubmitAsync(ctx, &ledgerpb.SubmitRequest{Function: "ChangeCarOwner", Args: []string{"CAR1", "Jo"}})
+	require.NoError(err)
+	require.Equal("tx1", resp.TxId)
+	st, err := lc.CommitStatus(ctx, &ledgerpb.CommitStatusRequest{TxId: "tx1"})
+	require.NoError(err)
+	require.Equal(&ledgerpb.TxStatus{TxId: "tx1", BlockNumber: 7, ValidationCode: "MVCC_READ_CONFLICT"}, st)
+
+	// from the ledger
+	lc, _, ctx = testLedger(t, nil)
+	st, err = lc.CommitStatus(ctx, &ledgerpb.CommitStatusRequest{TxId: "tx1"})
+	require.NoError(err)
+	require.True(st.Valid)
+	_, err = lc.CommitStatus(ctx, &ledgerpb.CommitStatusRequest{TxId: "tx2"})
+	require.Equal(codes.NotFound, status.Code(err))
+	_, err = lc.CommitStatus(ctx, &ledgerpb.CommitStatusRequest{})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func Test_LedgerService_ChaincodeEvents(t *testing.T) {
+	require := require.New(t)
+	lc, s, ctx := testLedger(t, nil)
+	var from uint64
+	var filter string
+	s.events = func(ct *Contract, ctx context.Context, f string, opts ...EventOption) (<-chan *ChaincodeEvent, error) {
+		filter = f
+		eo := eventOptions{}
+		for _, option := range opts {
+			option(&eo)
+		}
+		from = eo.from
+		ch := make(chan *ChaincodeEvent, 2)
+		ch <- &ChaincodeEvent{Chaincode: "fabcar", TxID: "tx1", Name: "CarCreated", BlockNumber: 7}
+		ch <- &ChaincodeEvent{Chaincode: "fabcar", TxID: "tx2", Name: "CarSold", Payload: []byte("p"), BlockNumber: 8}
+		close(ch)
+		return ch, nil
+	}
+
+	stream, err := lc.ChaincodeEvents(ctx, &ledgerpb.ChaincodeEventsRequest{Filter: "Car.*", StartBlock: 5})
+	require.NoError(err)
+	var got []*ledgerpb.ChaincodeEvent
+	for {
+		ev, err := stream.Recv()
+		if err != nil {
+			require.False(errors.Is(err, io.EOF))
+			require.Equal(codes.Unavailable, status.Code(err))
+			break
+		}
+		got = append(got, ev)
+	}
+	require.EqualValues(5, from)
+	require.Equal("Car.*", filter)
+	require.Equal([]*ledgerpb.ChaincodeEvent{
+		{Chaincode: "fabcar", TxId: "tx1", Name: "CarCreated", BlockNumber: 7},
+		{Chaincode: "fabcar", TxId: "tx2", Name: "CarSold", Payload: []byte("p"), BlockNumber: 8}}, got)
+}
+
+func Test_LedgerService_fail(t *testing.T) {
+	ls := &LedgerService{}
+	for _, tc := range []struct {
+		err  error
+		code codes.Code
+	}{
+		{nil, codes.OK},
+		{ErrRateLimited, codes.ResourceExhausted},
+		{ErrTxNotFound, codes.NotFound},
+		{context.Canceled, codes.Canceled},
+		{ErrEventStreamClosed, codes.Unavailable},
+		{status.Error(codes.Aborted, "aborted"), codes.Aborted},
+		{errors.New("boom"), codes.Unknown},
+	} {
+		require.Equal(t, tc.code, status.Code(ls.fail("user1", tc.err)), tc.code.String())
+	}
+}
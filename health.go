@@ -0,0 +1,257 @@
+// v0.2.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/core"
+	"github.com/spf13/viper"
+)
+
+// HealthPolicy defines the probe transaction of Client.Health.  The zero value
+// probes the ledger of the channel of the Client.
+type HealthPolicy struct {
+	// ProbeFunction is the transaction evaluated by the probe on the chaincode of
+	// the Client, e.g., MetadataFunction for a chaincode written with the
+	// contract API.  A qualified function is evaluated on the default contract.
+	ProbeFunction string
+}
+
+// loadHealthPolicy reads the section [health] of the configuration file.
+func loadHealthPolicy(vi *viper.Viper) HealthPolicy {
+	return HealthPolicy{ProbeFunction: vi.GetString("health.probeFunction")}
+}
+
+// WithHealthPolicy sets the probe of Client.Health regardless of the section
+// [health] of the configuration file.
+func WithHealthPolicy(hp HealthPolicy) ClientOption {
+	return func(cp *clientOptions) {
+		cp.health = &hp
+	}
+}
+
+// EndpointHealth reports the reachability of a node of the connection profile.
+type EndpointHealth struct {
+	Name      string        `json:"name"`
+	URL       string        `json:"url"`
+	Reachable bool          `json:"reachable"`
+	Latency   time.Duration `json:"latency"`
+	Error     string        `json:"error,omitempty"`
+}
+
+// HealthReport is the result of Client.Health.
+type HealthReport struct {
+	// Healthy is true if the probe transaction succeeded and the circuit breaker
+	// is not open.
+	Healthy bool `json:"healthy"`
+	// Probe is the error of the probe transaction, if any.
+	Probe        string           `json:"probe,omitempty"`
+	ProbeLatency time.Duration    `json:"probeLatency"`
+	Breaker      string           `json:"breaker"`
+	Peers        []EndpointHealth `json:"peers"`
+	Orderers     []EndpointHealth `json:"orderers"`
+	CAs          []EndpointHealth `json:"certificateAuthorities"`
+}
+
+// Health probes the blockchain.  It evaluates the probe transaction of the
+// HealthPolicy of the Client, or queries the ledger of its channel if there is
+// none, and opens a TCP connection to every peer, orderer and certificate
+// authority of the connection profile.  The probe bypasses the retry policy and
+// the circuit breaker.  `ctx` bounds the duration of the probe.
+func (c *Client) Health(ctx context.Context) (*HealthReport, error) {
+	if err := c.begin(); err != nil {
+		return nil, err
+	}
+	defer c.end()
+	rep := &HealthReport{Breaker: c.BreakerState().String()}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		start := time.Now()
+		if err := c.probe(ctx); err != nil {
+			rep.Probe = err.Error()
+		}
+		rep.ProbeLatency = time.Since(start)
+	}()
+
+	backends, err := c.configProvider()()
+	if err != nil {
+		Logr.Errorf("could not read connection profile: %v", err)
+		rep.Peers, rep.Orderers, rep.CAs = []EndpointHealth{}, []EndpointHealth{}, []EndpointHealth{}
+	} else {
+		rep.Peers = dialEndpoints(ctx, lookupEndpoints(backends, "peers"))
+		rep.Orderers = dialEndpoints(ctx, lookupEndpoints(backends, "orderers"))
+		rep.CAs = dialEndpoints(ctx, lookupEndpoints(backends, "certificateAuthorities"))
+	}
+	wg.Wait()
+	rep.Healthy = rep.Probe == "" && c.BreakerState() != BreakerOpen
+	return rep, nil
+}
+
+// probe evaluates the probe transaction, or queries the ledger.  The probe is a
+// call in flight until it returns, even if `ctx` is done first, thus Close waits
+// for it.
+func (c *Client) probe(ctx context.Context) error {
+	call, err := c.probeCall()
+	if err != nil {
+		return err
+	}
+	if err := c.begin(); err != nil {
+		return err
+	}
+	done := make(chan error, 1)
+	go func() {
+		defer c.end()
+		done <- call()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// probeCall returns the call probing the blockchain according to the
+// HealthPolicy of the Client.
+func (c *Client) probeCall() (func() error, error) {
+	fn := c.health.ProbeFunction
+	if fn == "" {
+		return func() error {
+			lc, err := c.ledgerClient(c.cfg.ChannelID)
+			if err != nil {
+				return err
+			}
+			_, err = lc.QueryInfo()
+			return err
+		}, nil
+	}
+	contract := c.contract
+	if c.cfg.ContractName != "" && strings.Contains(fn, ":") {
+		// the other contracts are not reachable through a named contract.
+		ct, err := c.Contract(c.cfg.ChannelID, c.cfg.ChainCodeID)
+		if err != nil {
+			return nil, err
+		}
+		contract = ct.contract
+	}
+	return func() error {
+		_, err := contract.EvaluateTransaction(fn)
+		return err
+	}, nil
+}
+
+// HealthHandler returns a HTTP handler reporting the health of `c` in JSON.  It
+// answers 503 if the Client is not healthy, thus suits a readiness probe.  Each
+// request is bounded by `timeout`.
+func HealthHandler(c *Client, timeout time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		w.Header().Set("Content-Type", "application/json")
+		rep, err := c.Health(ctx)
+		if err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			json.NewEncoder(w).Encode(map[string]interface{}{"healthy": false, "error": err.Error()})
+			return
+		}
+		if !rep.Healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(rep)
+	})
+}
+
+// lookupEndpoints returns the URL of the nodes listed in section `key` of the
+// connection profile, sorted by name.
+func lookupEndpoints(backends []core.ConfigBackend, key string) []EndpointHealth {
+	var res []EndpointHealth
+	for _, b := range backends {
+		v, ok := b.Lookup(key)
+		if !ok {
+			continue
+		}
+		nodes, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for name, n := range nodes {
+			node, ok := n.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			u, _ := node["url"].(string)
+			res = append(res, EndpointHealth{Name: name, URL: u})
+		}
+		break
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
+
+// dialEndpoints opens concurrently a TCP connection to each endpoint.
+func dialEndpoints(ctx context.Context, eps []EndpointHealth) []EndpointHealth {
+	res := make([]EndpointHealth, len(eps))
+	var wg sync.WaitGroup
+	for i := range eps {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res[i] = eps[i]
+			start := time.Now()
+			err := dial(ctx, eps[i].URL)
+			res[i].Latency = time.Since(start)
+			if err != nil {
+				res[i].Error = err.Error()
+				return
+			}
+			res[i].Reachable = true
+		}(i)
+	}
+	wg.Wait()
+	return res
+}
+
+// dial opens and closes a TCP connection to the host of `rawURL`, e.g.,
+// "grpcs://peer0.org1.example.com:7051".
+func dial(ctx context.Context, rawURL string) error {
+	host := rawURL
+	if strings.Contains(rawURL, "://") {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return err
+		}
+		host = u.Host
+		if u.Port() == "" {
+			switch u.Scheme {
+			case "https":
+				host = net.JoinHostPort(u.Hostname(), "443")
+			case "http":
+				host = net.JoinHostPort(u.Hostname(), "80")
+			}
+		}
+	}
+	if host == "" {
+		return fmt.Errorf("invalid url %q", rawURL)
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
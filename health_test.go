@@ -0,0 +1,135 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// healthClient returns a fake Client whose connection profile lists a listening
+// peer, an unreachable orderer and a listening CA.
+func healthClient(t *testing.T, f *fakeContract) *Client {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closed.Close()
+
+	dir, err := ioutil.TempDir("", "health")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	profile := fmt.Sprintf(`
+peers:
+  peer0.org1.example.com:
+    url: grpcs://%s
+orderers:
+  orderer.example.com:
+    url: grpcs://%s
+certificateAuthorities:
+  ca.org1.example.com:
+    url: https://%s
+`, l.Addr(), closed.Addr(), l.Addr())
+	file := filepath.Join(dir, "connection.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(profile), 0644))
+
+	c := fakeClient(f)
+	c.cfg.ConnectionFile = file
+	c.health = HealthPolicy{ProbeFunction: MetadataFunction}
+	return c
+}
+
+func Test_Client_Health(t *testing.T) {
+	require := require.New(t)
+
+	f := &fakeContract{name: "fabcar"}
+	c := healthClient(t, f)
+	rep, err := c.Health(context.Background())
+	require.NoError(err)
+	require.True(rep.Healthy)
+	require.Equal("closed", rep.Breaker)
+	require.Len(rep.Peers, 1)
+	require.True(rep.Peers[0].Reachable)
+	require.Equal("peer0.org1.example.com", rep.Peers[0].Name)
+	require.Len(rep.Orderers, 1)
+	require.False(rep.Orderers[0].Reachable)
+	require.NotEmpty(rep.Orderers[0].Error)
+	require.Len(rep.CAs, 1)
+	require.True(rep.CAs[0].Reachable)
+
+	// the probe times out
+	f.delay = time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	rep, err = c.Health(ctx)
+	require.NoError(err)
+	require.False(rep.Healthy)
+	require.Contains(rep.Probe, "deadline")
+
+	// Close waits for the probe.
+	c.Close()
+	require.Zero(atomic.LoadInt32(&f.inflight))
+	_, err = c.Health(context.Background())
+	require.ErrorIs(err, ErrClientClosed)
+}
+
+func Test_Client_Health_Probe(t *testing.T) {
+	require := require.New(t)
+
+	f := &fakeContract{name: "fabcar"}
+	c := healthClient(t, f)
+	c.health = HealthPolicy{ProbeFunction: "CountCars"}
+	rep, err := c.Health(context.Background())
+	require.NoError(err)
+	require.True(rep.Healthy)
+	require.EqualValues(1, f.calls)
+
+	// without probe function, the ledger is queried.
+	c.health = HealthPolicy{}
+	rep, err = c.Health(context.Background())
+	require.NoError(err)
+	require.False(rep.Healthy)
+	require.Equal(ErrWalletInitFailed.Error(), rep.Probe)
+	require.EqualValues(1, f.calls)
+}
+
+func Test_Configuration_Load_Health(t *testing.T) {
+	cp := loadTestConfig(t, "[health]\nprobeFunction = \"CountCars\"\n")
+	require.Equal(t, HealthPolicy{ProbeFunction: "CountCars"}, cp.Health)
+}
+
+func Test_HealthHandler(t *testing.T) {
+	require := require.New(t)
+
+	f := &fakeContract{name: "fabcar"}
+	c := healthClient(t, f)
+	h := HealthHandler(c, time.Second)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(http.StatusOK, w.Code)
+	var rep HealthReport
+	require.NoError(json.Unmarshal(w.Body.Bytes(), &rep))
+	require.True(rep.Healthy)
+
+	f.err = errors.New("chaincode fabcar not found")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(http.StatusServiceUnavailable, w.Code)
+}
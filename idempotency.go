@@ -0,0 +1,356 @@
+// v0.2.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// States of an IdempotencyRecord.
+const (
+	// IdempotencyPending means that the transaction may have been sent to the
+	// orderer but that its commit is not known.  The TxID is empty until the
+	// transaction is sent.
+	IdempotencyPending = "pending"
+	// IdempotencyCommitted means that the transaction is valid in the ledger.
+	IdempotencyCommitted = "committed"
+)
+
+// IdempotencyPendingTTL is the age after which a pending transaction that the
+// ledger does not hold is deemed lost, e.g., the process stopped before it
+// reached the orderer.  InvokeIdempotent then submits it again.
+const IdempotencyPendingTTL = 2 * DefaultCommitTimeout
+
+// IdempotencyRecord is the receipt of an idempotent submission.
+type IdempotencyRecord struct {
+	Key string `json:"key"`
+	// Digest identifies the function and arguments of the transaction.
+	Digest      string    `json:"digest"`
+	TxID        string    `json:"txID"`
+	State       string    `json:"state"`
+	Payload     []byte    `json:"payload,omitempty"`
+	BlockNumber uint64    `json:"blockNumber,omitempty"`
+	Created     time.Time `json:"created"`
+}
+
+// IdempotencyStore persists the IdempotencyRecords.  Implementations must be
+// safe for concurrent use.
+type IdempotencyStore interface {
+	// Get returns the record of `key`, or nil if there is none.
+	Get(key string) (*IdempotencyRecord, error)
+	// Put creates or replaces the record `r.Key`.
+	Put(r *IdempotencyRecord) error
+	// Delete removes the record of `key`, if any.
+	Delete(key string) error
+}
+
+// MemoryIdempotencyStore is an IdempotencyStore in memory.  It does not survive
+// the process.
+type MemoryIdempotencyStore struct {
+	mu      sync.Mutex
+	records map[string]IdempotencyRecord
+}
+
+// NewMemoryIdempotencyStore returns an empty MemoryIdempotencyStore.
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{records: make(map[string]IdempotencyRecord)}
+}
+
+// Get returns the record of `key`, or nil if there is none.
+func (m *MemoryIdempotencyStore) Get(key string) (*IdempotencyRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.records[key]
+	if !ok {
+		return nil, nil
+	}
+	return &r, nil
+}
+
+// Put creates or replaces the record `r.Key`.
+func (m *MemoryIdempotencyStore) Put(r *IdempotencyRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.records[r.Key] = *r
+	return nil
+}
+
+// Delete removes the record of `key`, if any.
+func (m *MemoryIdempotencyStore) Delete(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.records, key)
+	return nil
+}
+
+var bktIdempotency = []byte("idempotency")
+
+// BoltIdempotencyStore is an IdempotencyStore in a local bbolt database.
+type BoltIdempotencyStore struct {
+	db *bolt.DB
+}
+
+// NewBoltIdempotencyStore opens the IdempotencyStore of database file `dbFile`.
+// The file is created if it does not exist.
+func NewBoltIdempotencyStore(dbFile string) (*BoltIdempotencyStore, error) {
+	db, err := bolt.Open(dbFile, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		Logr.Errorf("could not open idempotency store %s: %v", dbFile, err)
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bktIdempotency)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &BoltIdempotencyStore{db: db}, nil
+}
+
+// Close closes the database.
+func (b *BoltIdempotencyStore) Close() error {
+	return b.db.Close()
+}
+
+// Get returns the record of `key`, or nil if there is none.
+func (b *BoltIdempotencyStore) Get(key string) (*IdempotencyRecord, error) {
+	var r *IdempotencyRecord
+	err := b.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(bktIdempotency).Get([]byte(key))
+		if v == nil {
+			return nil
+		}
+		r = &IdempotencyRecord{}
+		return json.Unmarshal(v, r)
+	})
+	return r, err
+}
+
+// Put creates or replaces the record `r.Key`.
+func (b *BoltIdempotencyStore) Put(r *IdempotencyRecord) error {
+	v, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bktIdempotency).Put([]byte(r.Key), v)
+	})
+}
+
+// Delete removes the record of `key`, if any.
+func (b *BoltIdempotencyStore) Delete(key string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bktIdempotency).Delete([]byte(key))
+	})
+}
+
+// Purge removes the records created before `before`.  It returns the number of
+// removed records.
+func (b *BoltIdempotencyStore) Purge(before time.Time) (int, error) {
+	n := 0
+	err := b.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bktIdempotency)
+		var keys [][]byte
+		err := b.ForEach(func(k, v []byte) error {
+			var r IdempotencyRecord
+			if err := json.Unmarshal(v, &r); err != nil {
+				return err
+			}
+			if r.Created.Before(before) {
+				keys = append(keys, k)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		// deleting while iterating would skip keys.
+		for _, k := range keys {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		n = len(keys)
+		return nil
+	})
+	return n, err
+}
+
+// keyLocks serializes the submissions sharing an idempotency key.
+type keyLocks struct {
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+type keyLock struct {
+	sync.Mutex
+	refs int
+}
+
+func (kl *keyLocks) lock(key string) func() {
+	kl.mu.Lock()
+	if kl.locks == nil {
+		kl.locks = make(map[string]*keyLock)
+	}
+	l, ok := kl.locks[key]
+	if !ok {
+		l = &keyLock{}
+		kl.locks[key] = l
+	}
+	l.refs++
+	kl.mu.Unlock()
+	l.Lock()
+	return func() {
+		l.Unlock()
+		kl.mu.Lock()
+		if l.refs--; l.refs == 0 {
+			delete(kl.locks, key)
+		}
+		kl.mu.Unlock()
+	}
+}
+
+// InvokeIdempotent submits the transaction `fn` with the arguments `args` at
+// most once per idempotency `key`.  It waits for the commit within `ctx`.  A
+// repeated call with the same key returns the stored result of the committed
+// transaction, or, if its commit was not known, checks the ledger for the
+// earlier transaction instead of submitting again.  It returns ErrTxPending if
+// the ledger does not hold it yet, unless it is older than IdempotencyPendingTTL,
+// in which case it is submitted again.  Invalid transactions are forgotten so that
+// they can be submitted again.  A key reused with other function or arguments
+// fails with ErrIdempotencyConflict.  The key is reserved in the store before the
+// transaction is sent; if the TxID could not be recorded, e.g., the process
+// stopped, the key stays pending until its record is deleted.  The Client needs
+// an IdempotencyStore, see WithIdempotencyStore.
+func (c *Client) InvokeIdempotent(ctx context.Context, key string, fn string, args ...string) ([]byte, error) {
+	if !c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	return c.invokeIdempotent(ctx, key, fn, args,
+		func() (*SubmitHandle, error) { return c.SubmitAsyncContext(ctx, fn, args...) },
+		c.TransactionStatus)
+}
+
+// invokeIdempotent implements InvokeIdempotent with `submit` sending the
+// transaction and `lookup` querying the ledger.
+func (c *Client) invokeIdempotent(ctx context.Context, key string, fn string, args []string,
+	submit func() (*SubmitHandle, error), lookup func(txID string) (*TxStatus, error)) ([]byte, error) {
+	if c.idemStore == nil {
+		return nil, ErrNoIdempotencyStore
+	}
+	unlock := c.idemLocks.lock(key)
+	defer unlock()
+
+	digest := callDigest(fn, args)
+	r, err := c.idemStore.Get(key)
+	if err != nil {
+		Logr.Errorf("could not read idempotency key %s: %v", key, err)
+		return nil, err
+	}
+	if r != nil {
+		if r.Digest != digest {
+			return nil, ErrIdempotencyConflict
+		}
+		if r.State == IdempotencyCommitted {
+			Logr.Debugf("idempotency key %s: transaction %s already committed", key, r.TxID)
+			return r.Payload, nil
+		}
+		if r.TxID == "" {
+			// the transaction may have been sent: only the ledger could tell.
+			Logr.Warnf("idempotency key %s: reserved without transaction", key)
+			return nil, ErrTxPending
+		}
+		st, err := lookup(r.TxID)
+		switch {
+		case errors.Is(err, ErrTxNotFound):
+			if time.Since(r.Created) < IdempotencyPendingTTL {
+				return nil, ErrTxPending
+			}
+			Logr.Warnf("idempotency key %s: transaction %s expired", key, r.TxID)
+		case err != nil:
+			return nil, err
+		case st.Valid:
+			return c.commitIdempotent(r, st)
+		default:
+			// an invalid transaction had no effect, thus it is submitted again.
+			Logr.Infof("idempotency key %s: transaction %s was %s", key, r.TxID, st.ValidationCode)
+		}
+	}
+
+	// the key is reserved before sending, so that no failure between the
+	// sending and the recording leads to a second transaction.
+	r = &IdempotencyRecord{Key: key, Digest: digest, State: IdempotencyPending, Created: time.Now()}
+	if err := c.idemStore.Put(r); err != nil {
+		Logr.Errorf("could not reserve idempotency key %s: %v", key, err)
+		return nil, err
+	}
+	h, err := submit()
+	if err != nil {
+		if IsSafeToResubmit(err) || !IsTransient(err) {
+			// the transaction did not reach the orderer.
+			c.forgetIdempotent(key)
+		}
+		return nil, err
+	}
+	r.TxID, r.Payload = h.TxID, h.Payload
+	if err := c.idemStore.Put(r); err != nil {
+		Logr.Errorf("could not record idempotency key %s for transaction %s: %v", key, h.TxID, err)
+		return nil, err
+	}
+	st, err := h.Status(ctx)
+	if st != nil && !st.Valid {
+		c.forgetIdempotent(key)
+		return nil, err
+	}
+	if err != nil {
+		// the outcome is unknown: the record stays pending.
+		return nil, err
+	}
+	return c.commitIdempotent(r, st)
+}
+
+// forgetIdempotent deletes the record of `key`, whose transaction had no effect.
+func (c *Client) forgetIdempotent(key string) {
+	if err := c.idemStore.Delete(key); err != nil {
+		Logr.Errorf("could not delete idempotency key %s: %v", key, err)
+	}
+}
+
+// commitIdempotent records that the transaction of `r` is committed.
+func (c *Client) commitIdempotent(r *IdempotencyRecord, st *TxStatus) ([]byte, error) {
+	r.State, r.BlockNumber = IdempotencyCommitted, st.BlockNumber
+	if err := c.idemStore.Put(r); err != nil {
+		Logr.Errorf("could not record commit of %s: %v", r.TxID, err)
+	}
+	return r.Payload, nil
+}
+
+// callDigest identifies the call of `fn` with `args`.
+func callDigest(fn string, args []string) string {
+	h := sha256.New()
+	for _, s := range append([]string{fn}, args...) {
+		b, _ := json.Marshal(s)
+		h.Write(b)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// WithIdempotencyStore sets the store of the receipts of InvokeIdempotent.  The
+// caller keeps the ownership of `store`.
+func WithIdempotencyStore(store IdempotencyStore) ClientOption {
+	return func(cp *clientOptions) {
+		cp.idemStore = store
+	}
+}
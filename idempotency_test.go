@@ -0,0 +1,200 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/stretchr/testify/require"
+)
+
+func testStores(t *testing.T) map[string]IdempotencyStore {
+	dir, err := ioutil.TempDir("", "idempotency")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	bs, err := NewBoltIdempotencyStore(filepath.Join(dir, "idem.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { bs.Close() })
+	return map[string]IdempotencyStore{"memory": NewMemoryIdempotencyStore(), "bolt": bs}
+}
+
+func Test_IdempotencyStore(t *testing.T) {
+	for name, st := range testStores(t) {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			r, err := st.Get("k1")
+			require.NoError(err)
+			require.Nil(r)
+			exp := &IdempotencyRecord{Key: "k1", TxID: "tx1", State: IdempotencyPending,
+				Payload: []byte("ok"), Created: time.Now().UTC().Truncate(time.Second)}
+			require.NoError(st.Put(exp))
+			r, err = st.Get("k1")
+			require.NoError(err)
+			require.Equal(exp, r)
+			require.NoError(st.Delete("k1"))
+			r, err = st.Get("k1")
+			require.NoError(err)
+			require.Nil(r)
+		})
+	}
+}
+
+func Test_BoltIdempotencyStore_Purge(t *testing.T) {
+	bs := testStores(t)["bolt"].(*BoltIdempotencyStore)
+	now := time.Now()
+	require.NoError(t, bs.Put(&IdempotencyRecord{Key: "old", Created: now.Add(-time.Hour)}))
+	require.NoError(t, bs.Put(&IdempotencyRecord{Key: "new", Created: now}))
+	n, err := bs.Purge(now.Add(-time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	r, _ := bs.Get("new")
+	require.NotNil(t, r)
+}
+
+// fakeSubmitter submits transactions committed with code `code`, or never
+// committed if `code` is nil.
+type fakeSubmitter struct {
+	n    int
+	code *peer.TxValidationCode
+}
+
+func (f *fakeSubmitter) submit() (*SubmitHandle, error) {
+	f.n++
+	txID := fmt.Sprintf("tx%d", f.n)
+	notifier := make(chan *fab.TxStatusEvent, 1)
+	if f.code != nil {
+		notifier <- &fab.TxStatusEvent{TxID: txID, TxValidationCode: *f.code, BlockNumber: 7}
+	}
+	return watchCommit(txID, []byte("res-"+txID), notifier, func() {}, time.Minute, nil), nil
+}
+
+func Test_Client_InvokeIdempotent(t *testing.T) {
+	require := require.New(t)
+
+	valid, mvcc := peer.TxValidationCode_VALID, peer.TxValidationCode_MVCC_READ_CONFLICT
+	c := fakeClient(&fakeContract{})
+	notFound := func(string) (*TxStatus, error) { return nil, ErrTxNotFound }
+	_, err := c.invokeIdempotent(context.Background(), "k", "createCar", nil, nil, notFound)
+	require.ErrorIs(err, ErrNoIdempotencyStore)
+
+	c.idemStore = NewMemoryIdempotencyStore()
+	fs := &fakeSubmitter{code: &valid}
+	args := []string{"CAR1", "Toyota"}
+	res, err := c.invokeIdempotent(context.Background(), "k1", "createCar", args, fs.submit, notFound)
+	require.NoError(err)
+	require.Equal("res-tx1", string(res))
+	// repeated: the stored result
+	res, err = c.invokeIdempotent(context.Background(), "k1", "createCar", args, fs.submit, notFound)
+	require.NoError(err)
+	require.Equal("res-tx1", string(res))
+	require.Equal(1, fs.n)
+	r, _ := c.idemStore.Get("k1")
+	require.Equal(IdempotencyCommitted, r.State)
+	require.EqualValues(7, r.BlockNumber)
+	// other arguments
+	_, err = c.invokeIdempotent(context.Background(), "k1", "createCar", []string{"CAR2"}, fs.submit, notFound)
+	require.ErrorIs(err, ErrIdempotencyConflict)
+
+	// commit unknown: the ledger is checked instead of submitting again
+	fs.code = nil
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = c.invokeIdempotent(ctx, "k2", "createCar", args, fs.submit, notFound)
+	require.ErrorIs(err, context.DeadlineExceeded)
+	_, err = c.invokeIdempotent(context.Background(), "k2", "createCar", args, fs.submit, notFound)
+	require.ErrorIs(err, ErrTxPending)
+	found := func(txID string) (*TxStatus, error) {
+		require.Equal("tx2", txID)
+		return &TxStatus{TxID: txID, ValidationCode: "VALID", Valid: true}, nil
+	}
+	res, err = c.invokeIdempotent(context.Background(), "k2", "createCar", args, fs.submit, found)
+	require.NoError(err)
+	require.Equal("res-tx2", string(res))
+	require.Equal(2, fs.n)
+
+	// a lost transaction is submitted again
+	fs.code = nil
+	_, err = c.invokeIdempotent(ctx, "k4", "createCar", args, fs.submit, notFound)
+	require.ErrorIs(err, context.DeadlineExceeded)
+	r, _ = c.idemStore.Get("k4")
+	r.Created = time.Now().Add(-IdempotencyPendingTTL)
+	require.NoError(c.idemStore.Put(r))
+	fs.code = &valid
+	res, err = c.invokeIdempotent(context.Background(), "k4", "createCar", args, fs.submit, notFound)
+	require.NoError(err)
+	require.Equal("res-tx4", string(res))
+
+	// invalid transactions are submitted again
+	fs.code = &mvcc
+	_, err = c.invokeIdempotent(context.Background(), "k3", "createCar", args, fs.submit, notFound)
+	require.True(IsTransient(err))
+	r, _ = c.idemStore.Get("k3")
+	require.Nil(r)
+	fs.code = &valid
+	res, err = c.invokeIdempotent(context.Background(), "k3", "createCar", args, fs.submit, notFound)
+	require.NoError(err)
+	require.Equal("res-tx6", string(res))
+}
+
+// failingStore is an IdempotencyStore whose writes fail with `err`.
+type failingStore struct {
+	*MemoryIdempotencyStore
+	err error
+}
+
+func (f *failingStore) Put(r *IdempotencyRecord) error {
+	if f.err != nil {
+		return f.err
+	}
+	return f.MemoryIdempotencyStore.Put(r)
+}
+
+func Test_Client_InvokeIdempotent_Reservation(t *testing.T) {
+	require := require.New(t)
+
+	valid := peer.TxValidationCode_VALID
+	c := fakeClient(&fakeContract{})
+	store := &failingStore{MemoryIdempotencyStore: NewMemoryIdempotencyStore()}
+	c.idemStore = store
+	fs := &fakeSubmitter{code: &valid}
+	notFound := func(string) (*TxStatus, error) { return nil, ErrTxNotFound }
+	args := []string{"CAR1", "Toyota"}
+
+	// nothing is sent without reservation
+	store.err = errors.New("disk full")
+	_, err := c.invokeIdempotent(context.Background(), "k1", "createCar", args, fs.submit, notFound)
+	require.ErrorIs(err, store.err)
+	require.Zero(fs.n)
+
+	// a rejected transaction releases its reservation
+	store.err = nil
+	rejected := func() (*SubmitHandle, error) { return nil, errors.New("car CAR1 already exists") }
+	_, err = c.invokeIdempotent(context.Background(), "k1", "createCar", args, rejected, notFound)
+	require.EqualError(err, "car CAR1 already exists")
+	r, _ := store.Get("k1")
+	require.Nil(r)
+
+	// a reservation without transaction is never submitted again
+	require.NoError(store.Put(&IdempotencyRecord{Key: "k2", Digest: callDigest("createCar", args),
+		State: IdempotencyPending, Created: time.Now().Add(-2 * IdempotencyPendingTTL)}))
+	_, err = c.invokeIdempotent(context.Background(), "k2", "createCar", args, fs.submit, notFound)
+	require.ErrorIs(err, ErrTxPending)
+	require.Zero(fs.n)
+
+	res, err := c.invokeIdempotent(context.Background(), "k3", "createCar", args, fs.submit, notFound)
+	require.NoError(err)
+	require.Equal("res-tx1", string(res))
+	r, _ = store.Get("k3")
+	require.Equal("tx1", r.TxID)
+}
@@ -0,0 +1,354 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// Buckets of the index database.
+var (
+	bktMeta     = []byte("meta")     // checkpoint
+	bktTx       = []byte("tx")       // txID -> Transaction
+	bktKeys     = []byte("keys")     // namespace|key|block|tx -> KeyWrite
+	bktCreators = []byte("creators") // msp|subject|time|txID -> nil
+	bktTime     = []byte("time")     // time|txID -> nil
+	bktEvents   = []byte("events")   // chaincode|name|block|tx -> ChaincodeEvent
+
+	keyCheckpoint = []byte("checkpoint")
+)
+
+// sep separates the components of the composite keys of the index.
+const sep = 0x00
+
+// Indexer stores the transactions, the history of the written keys and the
+// chaincode events of a channel into a local bbolt database.  It records the
+// last indexed block so that it restarts where it stopped.
+type Indexer struct {
+	c       *Client
+	db      *bolt.DB
+	channel string
+}
+
+// KeyWrite is an entry of the history of a key.
+type KeyWrite struct {
+	TxID        string    `json:"txId"`
+	BlockNumber uint64    `json:"blockNumber"`
+	TxIndex     int       `json:"txIndex"`
+	Timestamp   time.Time `json:"timestamp"`
+	Value       []byte    `json:"value,omitempty"`
+	IsDelete    bool      `json:"isDelete,omitempty"`
+}
+
+// NewIndexer creates an Indexer of the channel of Client `c` storing the index in
+// the database file `dbFile`.  The file is created if it does not exist.
+// `c` may be nil if the Indexer is only used for queries.
+func NewIndexer(c *Client, dbFile string) (*Indexer, error) {
+	db, err := bolt.Open(dbFile, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		Logr.Errorf("could not open index %s: %v", dbFile, err)
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, b := range [][]byte{bktMeta, bktTx, bktKeys, bktCreators, bktTime, bktEvents} {
+			if _, err := tx.CreateBucketIfNotExists(b); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	ix := &Indexer{c: c, db: db}
+	if c != nil {
+		ix.channel = c.cfg.ChannelID
+	}
+	return ix, nil
+}
+
+// Close closes the database of the Indexer.
+func (ix *Indexer) Close() error {
+	return ix.db.Close()
+}
+
+// Run consumes the blocks of the channel starting after the checkpoint and
+// indexes them until `ctx` is cancelled or the event stream fails.
+func (ix *Indexer) Run(ctx context.Context) error {
+	if ix.c == nil {
+		return ErrClientNotInitialized
+	}
+	var from uint64
+	last, ok, err := ix.Checkpoint()
+	if err != nil {
+		return err
+	}
+	if ok {
+		from = last + 1
+	}
+	ec, err := ix.c.blockEventClient(ix.channel, from)
+	if err != nil {
+		Logr.Errorf("indexer: could not create event client: %v", err)
+		return err
+	}
+	reg, events, err := ec.RegisterBlockEvent()
+	if err != nil {
+		Logr.Errorf("indexer: could not register block events: %v", err)
+		return err
+	}
+	defer ec.Unregister(reg)
+	Logr.Infof("indexer: consuming channel %s from block %d", ix.channel, from)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev, ok := <-events:
+			if !ok {
+				return ErrEventStreamClosed
+			}
+			blk, err := DecodeBlock(ev.Block)
+			if err != nil {
+				Logr.Errorf("indexer: %v", err)
+				return err
+			}
+			if err := ix.Index(blk); err != nil {
+				Logr.Errorf("indexer: could not index block %d: %v", blk.Number, err)
+				return err
+			}
+		}
+	}
+}
+
+// Checkpoint returns the number of the last indexed block.  It returns false if
+// no block was indexed yet.
+func (ix *Indexer) Checkpoint() (uint64, bool, error) {
+	var n uint64
+	var ok bool
+	err := ix.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(bktMeta).Get(keyCheckpoint)
+		if v != nil {
+			n, ok = binary.BigEndian.Uint64(v), true
+		}
+		return nil
+	})
+	return n, ok, err
+}
+
+// Index stores the block `blk` and moves the checkpoint to it in one database
+// transaction.  Blocks at or below the checkpoint are ignored.  Only valid
+// transactions contribute to the history of keys and to the events.
+func (ix *Indexer) Index(blk *Block) error {
+	return ix.db.Update(func(tx *bolt.Tx) error {
+		meta := tx.Bucket(bktMeta)
+		if v := meta.Get(keyCheckpoint); v != nil && binary.BigEndian.Uint64(v) >= blk.Number {
+			return nil
+		}
+		for _, t := range blk.Transactions {
+			if err := indexTransaction(tx, t); err != nil {
+				return err
+			}
+		}
+		return meta.Put(keyCheckpoint, u64(blk.Number))
+	})
+}
+
+// indexTransaction stores `t` in the buckets of `tx`.
+func indexTransaction(tx *bolt.Tx, t *Transaction) error {
+	if t.TxID == "" {
+		// config blocks
+		return nil
+	}
+	txs := tx.Bucket(bktTx)
+	if old := txs.Get([]byte(t.TxID)); old != nil {
+		// a duplicate TxID is always invalid.  Keeps the first one.
+		return nil
+	}
+	data, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	if err := txs.Put([]byte(t.TxID), data); err != nil {
+		return err
+	}
+	ts := timeKey(t.Timestamp)
+	err = tx.Bucket(bktTime).Put(compositeKey(ts, []byte(t.TxID)), nil)
+	if err != nil {
+		return err
+	}
+	err = tx.Bucket(bktCreators).Put(compositeKey([]byte(t.CreatorMSP), []byte(t.CreatorSubject), ts, []byte(t.TxID)), nil)
+	if err != nil {
+		return err
+	}
+	if !t.Valid {
+		return nil
+	}
+
+	pos := append(u64(t.BlockNumber), u64(uint64(t.Index))...)
+	for _, set := range t.RWSets {
+		for _, w := range set.Writes {
+			kw := &KeyWrite{TxID: t.TxID, BlockNumber: t.BlockNumber, TxIndex: t.Index,
+				Timestamp: t.Timestamp, Value: w.Value, IsDelete: w.IsDelete}
+			data, err := json.Marshal(kw)
+			if err != nil {
+				return err
+			}
+			err = tx.Bucket(bktKeys).Put(compositeKey([]byte(set.Namespace), []byte(w.Key), pos), data)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for i, ev := range t.Events {
+		data, err := json.Marshal(ev)
+		if err != nil {
+			return err
+		}
+		k := compositeKey([]byte(ev.Chaincode), []byte(ev.Name), pos, u64(uint64(i)))
+		if err := tx.Bucket(bktEvents).Put(k, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Transaction returns the indexed transaction `txID`.  It returns ErrNotIndexed
+// if it is unknown.
+func (ix *Indexer) Transaction(txID string) (*Transaction, error) {
+	var t *Transaction
+	err := ix.db.View(func(tx *bolt.Tx) error {
+		var err error
+		t, err = getTransaction(tx, txID)
+		return err
+	})
+	return t, err
+}
+
+// KeyHistory returns the successive writes of `key` of chaincode `namespace`
+// in ledger order.
+func (ix *Indexer) KeyHistory(namespace string, key string) ([]*KeyWrite, error) {
+	var res []*KeyWrite
+	prefix := compositeKey([]byte(namespace), []byte(key), nil)
+	err := ix.db.View(func(tx *bolt.Tx) error {
+		cur := tx.Bucket(bktKeys).Cursor()
+		for k, v := cur.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = cur.Next() {
+			if len(k) != len(prefix)+16 {
+				// composite keys of the chaincode may share the prefix.
+				continue
+			}
+			kw := &KeyWrite{}
+			if err := json.Unmarshal(v, kw); err != nil {
+				return err
+			}
+			res = append(res, kw)
+		}
+		return nil
+	})
+	return res, err
+}
+
+// TransactionsByCreator returns the transactions submitted by members of MSP
+// `mspID` in chronological order.  If `subject` is not empty, only the
+// transactions whose creator's certificate has this subject are returned.
+func (ix *Indexer) TransactionsByCreator(mspID string, subject string) ([]*Transaction, error) {
+	prefix := compositeKey([]byte(mspID), nil)
+	if subject != "" {
+		prefix = compositeKey([]byte(mspID), []byte(subject), nil)
+	}
+	var res []*Transaction
+	err := ix.db.View(func(tx *bolt.Tx) error {
+		cur := tx.Bucket(bktCreators).Cursor()
+		for k, _ := cur.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = cur.Next() {
+			t, err := getTransaction(tx, string(k[bytes.LastIndexByte(k, sep)+1:]))
+			if err != nil {
+				return err
+			}
+			res = append(res, t)
+		}
+		return nil
+	})
+	return res, err
+}
+
+// TransactionsBetween returns the transactions whose timestamp is within
+// [`from`, `to`) in chronological order.
+func (ix *Indexer) TransactionsBetween(from time.Time, to time.Time) ([]*Transaction, error) {
+	start := timeKey(from)
+	end := timeKey(to)
+	var res []*Transaction
+	err := ix.db.View(func(tx *bolt.Tx) error {
+		cur := tx.Bucket(bktTime).Cursor()
+		for k, _ := cur.Seek(start); k != nil && bytes.Compare(k[:8], end) < 0; k, _ = cur.Next() {
+			t, err := getTransaction(tx, string(k[9:]))
+			if err != nil {
+				return err
+			}
+			res = append(res, t)
+		}
+		return nil
+	})
+	return res, err
+}
+
+// Events returns the events `name` emitted by chaincode `chaincode` in ledger
+// order.  If `name` is empty, it returns all the events of the chaincode.
+func (ix *Indexer) Events(chaincode string, name string) ([]*ChaincodeEvent, error) {
+	prefix := compositeKey([]byte(chaincode), nil)
+	if name != "" {
+		prefix = compositeKey([]byte(chaincode), []byte(name), nil)
+	}
+	var res []*ChaincodeEvent
+	err := ix.db.View(func(tx *bolt.Tx) error {
+		cur := tx.Bucket(bktEvents).Cursor()
+		for k, v := cur.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = cur.Next() {
+			ev := &ChaincodeEvent{}
+			if err := json.Unmarshal(v, ev); err != nil {
+				return err
+			}
+			res = append(res, ev)
+		}
+		return nil
+	})
+	return res, err
+}
+
+// getTransaction reads transaction `txID` within `tx`.
+func getTransaction(tx *bolt.Tx, txID string) (*Transaction, error) {
+	v := tx.Bucket(bktTx).Get([]byte(txID))
+	if v == nil {
+		return nil, ErrNotIndexed
+	}
+	t := &Transaction{}
+	return t, json.Unmarshal(v, t)
+}
+
+// compositeKey joins `parts` with the separator.  A nil last part results in a
+// trailing separator, which is useful for prefix scans.
+func compositeKey(parts ...[]byte) []byte {
+	return bytes.Join(parts, []byte{sep})
+}
+
+// u64 returns the big endian encoding of `n`, which sorts in numerical order.
+// timeKey returns the key of `t` in the time index.  The times before 1970,
+// e.g., a missing timestamp, sort first.
+func timeKey(t time.Time) []byte {
+	if t.Before(time.Unix(0, 0)) {
+		return u64(0)
+	}
+	return u64(uint64(t.UnixNano()))
+}
+
+func u64(n uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, n)
+	return b
+}
@@ -0,0 +1,107 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Indexer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "indexer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	dbFile := filepath.Join(dir, "index.db")
+
+	ix, err := NewIndexer(nil, dbFile)
+	require.NoError(t, err)
+	_, ok, err := ix.Checkpoint()
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	cert := testCert(t)
+	valid := []byte{byte(peer.TxValidationCode_VALID), byte(peer.TxValidationCode_MVCC_READ_CONFLICT)}
+	for n, ids := range [][]string{{"tx1", "tx2"}, {"tx3", "tx4"}} {
+		b := &common.Block{
+			Header: &common.BlockHeader{Number: uint64(n)},
+			Data: &common.BlockData{Data: [][]byte{
+				testEnvelope(t, ids[0], cert, "createCar", "CAR1", "VW"),
+				testEnvelope(t, ids[1], cert, "createCar", "CAR2", "VW"),
+			}},
+			Metadata: &common.BlockMetadata{Metadata: [][]byte{nil, nil, valid}},
+		}
+		blk, err := DecodeBlock(b)
+		require.NoError(t, err)
+		require.NoError(t, ix.Index(blk))
+		// indexing twice is harmless.
+		require.NoError(t, ix.Index(blk))
+	}
+	require.NoError(t, ix.Close())
+
+	ix, err = NewIndexer(nil, dbFile)
+	require.NoError(t, err)
+	defer ix.Close()
+	last, ok, err := ix.Checkpoint()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), last)
+
+	tx, err := ix.Transaction("tx3")
+	require.NoError(t, err)
+	require.Equal(t, "createCar", tx.Function)
+	require.Equal(t, uint64(1), tx.BlockNumber)
+	_, err = ix.Transaction("unknown")
+	require.ErrorIs(t, err, ErrNotIndexed)
+
+	h, err := ix.KeyHistory("fabcar", "CAR1")
+	require.NoError(t, err)
+	require.Len(t, h, 2)
+	require.Equal(t, "tx1", h[0].TxID)
+	require.Equal(t, "tx3", h[1].TxID)
+	h, err = ix.KeyHistory("fabcar", "CAR2")
+	require.NoError(t, err)
+	require.Empty(t, h, "invalid transactions do not write")
+
+	txs, err := ix.TransactionsByCreator("Org1MSP", "")
+	require.NoError(t, err)
+	require.Len(t, txs, 4)
+	txs, err = ix.TransactionsByCreator("Org1MSP", tx.CreatorSubject)
+	require.NoError(t, err)
+	require.Len(t, txs, 4)
+	txs, err = ix.TransactionsByCreator("Org2MSP", "")
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	ts := time.Unix(1600000000, 0)
+	txs, err = ix.TransactionsBetween(ts, ts.Add(time.Second))
+	require.NoError(t, err)
+	require.Len(t, txs, 4)
+	txs, err = ix.TransactionsBetween(ts.Add(time.Second), ts.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	evs, err := ix.Events("fabcar", "CarCreated")
+	require.NoError(t, err)
+	require.Len(t, evs, 2)
+	evs, err = ix.Events("fabcar", "")
+	require.NoError(t, err)
+	require.Len(t, evs, 2)
+}
+
+func Test_timeKey(t *testing.T) {
+	now := time.Now()
+	require.Equal(t, u64(0), timeKey(time.Time{}))
+	require.Equal(t, u64(0), timeKey(time.Date(1969, 7, 20, 20, 17, 0, 0, time.UTC)))
+	require.Equal(t, -1, bytes.Compare(timeKey(time.Time{}), timeKey(now)))
+	require.Equal(t, -1, bytes.Compare(timeKey(now), timeKey(now.Add(time.Nanosecond))))
+}
@@ -0,0 +1,105 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// maxPayloadInError is the maximal number of bytes of payload reported by
+// DecodeError.Error.
+const maxPayloadInError = 256
+
+// DecodeError occurs when the result of transaction `Fn` cannot be decoded.
+// `Payload` holds the raw result returned by the chaincode.
+type DecodeError struct {
+	Fn      string
+	Payload []byte
+	Err     error
+}
+
+func (e *DecodeError) Error() string {
+	p := e.Payload
+	if len(p) > maxPayloadInError {
+		p = p[:maxPayloadInError]
+	}
+	return fmt.Sprintf("could not decode result of %s: %v (payload %q)", e.Fn, e.Err, p)
+}
+
+// Unwrap returns the underlying JSON error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// QueryJSON evaluates the transaction `fn` with the arguments `args` and decodes
+// the JSON result into `out`.  Arguments that are not strings are marshalled in
+// JSON.  `out` may be nil if the result is not needed.  If `out` is a *string, it
+// receives the raw result.
+func (c *Client) QueryJSON(fn string, out interface{}, args ...interface{}) error {
+	return callJSON(c.Query, fn, out, args)
+}
+
+// InvokeJSON submits the transaction `fn` with the arguments `args` and decodes
+// the JSON result into `out`.  Arguments that are not strings are marshalled in
+// JSON.  `out` may be nil if the result is not needed.  If `out` is a *string, it
+// receives the raw result.
+func (c *Client) InvokeJSON(fn string, out interface{}, args ...interface{}) error {
+	return callJSON(c.Invoke, fn, out, args)
+}
+
+// callJSON marshals `args`, calls `fn` with `call` and decodes the result into
+// `out`.
+func callJSON(call func(string, ...string) ([]byte, error), fn string, out interface{}, args []interface{}) error {
+	sArgs, err := marshalArgs(args)
+	if err != nil {
+		return err
+	}
+	res, err := call(fn, sArgs...)
+	if err != nil {
+		return err
+	}
+	return decodeResult(fn, res, out)
+}
+
+// marshalArgs converts `args` into the string arguments of a transaction.
+// Strings and byte slices are passed unchanged, other values are marshalled in
+// JSON.
+func marshalArgs(args []interface{}) ([]string, error) {
+	res := make([]string, len(args))
+	for i, a := range args {
+		switch v := a.(type) {
+		case string:
+			res[i] = v
+		case []byte:
+			res[i] = string(v)
+		default:
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("could not marshal argument %d: %w", i, err)
+			}
+			res[i] = string(b)
+		}
+	}
+	return res, nil
+}
+
+// decodeResult unmarshals the result `payload` of transaction `fn` into `out`.
+// An empty payload leaves `out` unchanged.  If `out` is a *string, it receives
+// the raw payload as chaincodes return strings without JSON quoting.
+func decodeResult(fn string, payload []byte, out interface{}) error {
+	if out == nil || len(payload) == 0 {
+		return nil
+	}
+	if s, ok := out.(*string); ok {
+		*s = string(payload)
+		return nil
+	}
+	if err := json.Unmarshal(payload, out); err != nil {
+		Logr.Debugf("could not decode result of %s: %v", fn, err)
+		return &DecodeError{Fn: fn, Payload: payload, Err: err}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type car struct {
+	Make  string `json:"make"`
+	Model string `json:"model"`
+}
+
+func Test_marshalArgs(t *testing.T) {
+	args, err := marshalArgs([]interface{}{"CAR1", []byte("raw"), 12, true, car{Make: "VW", Model: "Polo"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"CAR1", "raw", "12", "true", `{"make":"VW","model":"Polo"}`}, args)
+
+	_, err = marshalArgs([]interface{}{make(chan int)})
+	require.Error(t, err)
+}
+
+func Test_decodeResult(t *testing.T) {
+	var c car
+	require.NoError(t, decodeResult("queryCar", []byte(`{"make":"VW","model":"Polo"}`), &c))
+	require.Equal(t, car{Make: "VW", Model: "Polo"}, c)
+
+	require.NoError(t, decodeResult("queryCar", nil, &c))
+	var s string
+	require.NoError(t, decodeResult("getOwner", []byte("Mary"), &s))
+	require.Equal(t, "Mary", s)
+	require.NoError(t, decodeResult("queryCar", []byte("whatever"), nil))
+
+	err := decodeResult("queryCar", []byte("not json"), &c)
+	var de *DecodeError
+	require.True(t, errors.As(err, &de))
+	require.Equal(t, "queryCar", de.Fn)
+	require.Equal(t, []byte("not json"), de.Payload)
+	var se *json.SyntaxError
+	require.True(t, errors.As(err, &se))
+	require.Contains(t, err.Error(), "not json")
+}
@@ -0,0 +1,214 @@
+// v0.3.3
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"errors"
+
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/peer"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/event"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/ledger"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/errors/status"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/context"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/core"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/msp"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fab/events/deliverclient/seek"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
+	"github.com/hyperledger/fabric-sdk-go/pkg/gateway"
+)
+
+// BlockHeight returns the number of blocks of the channel of the Client.
+func (c *Client) BlockHeight() (uint64, error) {
+	if !c.initialized {
+		return 0, ErrClientNotInitialized
+	}
+	lc, err := c.ledgerClient(c.cfg.ChannelID)
+	if err != nil {
+		return 0, err
+	}
+	info, err := lc.QueryInfo()
+	if err != nil {
+		Logr.Errorf("could not query ledger info: %v", err)
+		return 0, err
+	}
+	return info.BCI.Height, nil
+}
+
+// Block returns the decoded block `number` of the channel of the Client.
+func (c *Client) Block(number uint64) (*Block, error) {
+	if !c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	lc, err := c.ledgerClient(c.cfg.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	b, err := lc.QueryBlock(number)
+	if err != nil {
+		Logr.Errorf("could not query block %d: %v", number, err)
+		return nil, err
+	}
+	return DecodeBlock(b)
+}
+
+// TransactionStatus returns the validation status of the transaction `txID` of
+// the channel of the Client.  It returns ErrTxNotFound if the ledger does not
+// hold the transaction.  BlockNumber is not reported.
+func (c *Client) TransactionStatus(txID string) (*TxStatus, error) {
+	if !c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	lc, err := c.ledgerClient(c.cfg.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	ptx, err := lc.QueryTransaction(fab.TransactionID(txID))
+	if err != nil {
+		if isTxNotFound(err) {
+			return nil, ErrTxNotFound
+		}
+		Logr.Errorf("could not query transaction %s: %v", txID, err)
+		return nil, err
+	}
+	code := peer.TxValidationCode(ptx.GetValidationCode())
+	return &TxStatus{
+		TxID:           txID,
+		ValidationCode: code.String(),
+		Valid:          code == peer.TxValidationCode_VALID,
+	}, nil
+}
+
+// isTxNotFound returns true if `err` is the failure of the query of a
+// transaction that the ledger does not hold.  Every peer answers with an error
+// status of the system chaincode qscc, whereas an unreachable peer fails with
+// another status group.
+func isTxNotFound(err error) bool {
+	return matchStatus(err, func(s *status.Status) bool {
+		return s.Group == status.ChaincodeStatus && s.Code == int32(common.Status_INTERNAL_SERVER_ERROR)
+	})
+}
+
+// matchStatus returns true if the status of `err` matches `match`.  The status
+// of the failures of several peers matches if the status of each failure does.
+func matchStatus(err error, match func(s *status.Status) bool) bool {
+	s, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	if s.Group == status.ClientStatus && s.Code == status.MultipleErrors.ToInt32() {
+		for _, d := range s.Details {
+			if e, ok := d.(error); !ok || !matchStatus(e, match) {
+				return false
+			}
+		}
+		return len(s.Details) > 0
+	}
+	return match(s)
+}
+
+// ledgerClient returns a ledger client on channel `channelID`.
+func (c *Client) ledgerClient(channelID string) (*ledger.Client, error) {
+	cp, err := c.channelContext(channelID)
+	if err != nil {
+		return nil, err
+	}
+	return ledger.New(cp)
+}
+
+// blockEventClient returns an event client on channel `channelID` delivering
+// full blocks starting with block `from`.
+func (c *Client) blockEventClient(channelID string, from uint64) (*event.Client, error) {
+	cp, err := c.channelContext(channelID)
+	if err != nil {
+		return nil, err
+	}
+	return event.New(cp, event.WithBlockEvents(), event.WithSeekType(seek.FromBlock), event.WithBlockNum(from))
+}
+
+// channelContext returns the context of channel `channelID` for the identity of
+// the Client.  The gateway does not expose its SDK, thus the Client uses a second
+// SDK instance built from the same connection file and wallet identity.  It is
+// created at first use.
+func (c *Client) channelContext(channelID string) (context.ChannelProvider, error) {
+	if err := c.begin(); err != nil {
+		return nil, err
+	}
+	defer c.end()
+	sdk, id, err := c.walletSDK()
+	if err != nil {
+		return nil, err
+	}
+	return sdk.ChannelContext(channelID, fabsdk.WithIdentity(id)), nil
+}
+
+// walletSDK returns the second SDK instance of the Client and the signing
+// identity of its user.  It is created at first use.
+func (c *Client) walletSDK() (*fabsdk.FabricSDK, msp.SigningIdentity, error) {
+	c.ledgerMu.Lock()
+	defer c.ledgerMu.Unlock()
+	if c.sdk == nil {
+		sdk, id, err := newWalletSDK(c.configProvider(), c.wallet, c.cfg.User)
+		if err != nil {
+			return nil, nil, err
+		}
+		c.sdk, c.identity = sdk, id
+	}
+	return c.sdk, c.identity, nil
+}
+
+// closeLedger releases the SDK instance used for the ledger access, if any.
+func (c *Client) closeLedger() {
+	c.ledgerMu.Lock()
+	defer c.ledgerMu.Unlock()
+	if c.sdk != nil {
+		c.sdk.Close()
+		c.sdk, c.channelClients = nil, nil
+	}
+}
+
+// newWalletSDK creates a SDK instance from the connection profile `provider` and
+// the signing identity of `user` stored in `wallet`.
+func newWalletSDK(provider core.ConfigProvider, wallet *gateway.Wallet, user string) (*fabsdk.FabricSDK, msp.SigningIdentity, error) {
+	if wallet == nil {
+		return nil, nil, ErrWalletInitFailed
+	}
+	id, err := wallet.Get(user)
+	if err != nil {
+		Logr.Errorf("could not get %s from wallet: %v", user, err)
+		return nil, nil, ErrWalletInitFailed
+	}
+	x509id, ok := id.(*gateway.X509Identity)
+	if !ok {
+		return nil, nil, ErrWalletInitFailed
+	}
+
+	sdk, err := fabsdk.New(provider)
+	if err != nil {
+		Logr.Errorf("could not create ledger SDK: %v", err)
+		return nil, nil, ErrSDKFailed
+	}
+	ctx, err := sdk.Context()()
+	if err != nil {
+		sdk.Close()
+		return nil, nil, err
+	}
+	org := ctx.IdentityConfig().Client().Organization
+	mgr, ok := ctx.IdentityManager(org)
+	if !ok {
+		sdk.Close()
+		return nil, nil, errors.New("no identity manager for organization " + org)
+	}
+	si, err := mgr.CreateSigningIdentity(msp.WithCert([]byte(x509id.Certificate())),
+		msp.WithPrivateKey([]byte(x509id.Key())))
+	if err != nil {
+		sdk.Close()
+		Logr.Errorf("could not create signing identity of %s: %v", user, err)
+		return nil, nil, ErrWalletInitFailed
+	}
+	return sdk, si, nil
+}
@@ -0,0 +1,29 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/errors/multi"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/errors/status"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_isTxNotFound(t *testing.T) {
+	require := require.New(t)
+
+	notFound := status.New(status.ChaincodeStatus, 500,
+		"Failed to get transaction with id tx1, error entry not found in index", nil)
+	unavailable := status.New(status.EndorserClientStatus, status.ConnectionFailed.ToInt32(),
+		"connection failed", nil)
+	require.True(isTxNotFound(pkgerrors.WithMessage(notFound, "QueryTransaction failed")))
+	require.True(isTxNotFound(pkgerrors.WithMessage(multi.New(notFound, notFound), "QueryTransaction failed")))
+	require.False(isTxNotFound(pkgerrors.WithMessage(multi.New(notFound, unavailable), "QueryTransaction failed")))
+	require.False(isTxNotFound(unavailable))
+	require.False(isTxNotFound(errors.New("no such transaction ID [tx1] in index")))
+}
@@ -0,0 +1,9 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+// Package ledgerpb holds the gRPC service Ledger served by
+// blockchain.LedgerService and its generated Go client.
+package ledgerpb
+
+//go:generate protoc -I.. --go_out=plugins=grpc,paths=source_relative:.. ledgerpb/ledger.proto
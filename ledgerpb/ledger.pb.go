@@ -0,0 +1,896 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: ledgerpb/ledger.proto
+
+package ledgerpb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Target selects a chaincode.  The empty fields default to the configuration of
+// the server.
+type Target struct {
+	Channel   string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Chaincode string `protobuf:"bytes,2,opt,name=chaincode,proto3" json:"chaincode,omitempty"`
+	// contract is the contract of a multi-contract chaincode.
+	Contract             string   `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Target) Reset()         { *m = Target{} }
+func (m *Target) String() string { return proto.CompactTextString(m) }
+func (*Target) ProtoMessage()    {}
+func (*Target) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6b9b1971fdd663a, []int{0}
+}
+
+func (m *Target) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Target.Unmarshal(m, b)
+}
+func (m *Target) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Target.Marshal(b, m, deterministic)
+}
+func (m *Target) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Target.Merge(m, src)
+}
+func (m *Target) XXX_Size() int {
+	return xxx_messageInfo_Target.Size(m)
+}
+func (m *Target) XXX_DiscardUnknown() {
+	xxx_messageInfo_Target.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Target proto.InternalMessageInfo
+
+func (m *Target) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *Target) GetChaincode() string {
+	if m != nil {
+		return m.Chaincode
+	}
+	return ""
+}
+
+func (m *Target) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+type EvaluateRequest struct {
+	Target               *Target  `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Function             string   `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
+	Args                 []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvaluateRequest) Reset()         { *m = EvaluateRequest{} }
+func (m *EvaluateRequest) String() string { return proto.CompactTextString(m) }
+func (*EvaluateRequest) ProtoMessage()    {}
+func (*EvaluateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6b9b1971fdd663a, []int{1}
+}
+
+func (m *EvaluateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvaluateRequest.Unmarshal(m, b)
+}
+func (m *EvaluateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvaluateRequest.Marshal(b, m, deterministic)
+}
+func (m *EvaluateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateRequest.Merge(m, src)
+}
+func (m *EvaluateRequest) XXX_Size() int {
+	return xxx_messageInfo_EvaluateRequest.Size(m)
+}
+func (m *EvaluateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluateRequest proto.InternalMessageInfo
+
+func (m *EvaluateRequest) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *EvaluateRequest) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *EvaluateRequest) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type EvaluateResponse struct {
+	Result               []byte   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvaluateResponse) Reset()         { *m = EvaluateResponse{} }
+func (m *EvaluateResponse) String() string { return proto.CompactTextString(m) }
+func (*EvaluateResponse) ProtoMessage()    {}
+func (*EvaluateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6b9b1971fdd663a, []int{2}
+}
+
+func (m *EvaluateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvaluateResponse.Unmarshal(m, b)
+}
+func (m *EvaluateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvaluateResponse.Marshal(b, m, deterministic)
+}
+func (m *EvaluateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateResponse.Merge(m, src)
+}
+func (m *EvaluateResponse) XXX_Size() int {
+	return xxx_messageInfo_EvaluateResponse.Size(m)
+}
+func (m *EvaluateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluateResponse proto.InternalMessageInfo
+
+func (m *EvaluateResponse) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type SubmitRequest struct {
+	Target               *Target  `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Function             string   `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
+	Args                 []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubmitRequest) Reset()         { *m = SubmitRequest{} }
+func (m *SubmitRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitRequest) ProtoMessage()    {}
+func (*SubmitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6b9b1971fdd663a, []int{3}
+}
+
+func (m *SubmitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitRequest.Unmarshal(m, b)
+}
+func (m *SubmitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitRequest.Marshal(b, m, deterministic)
+}
+func (m *SubmitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitRequest.Merge(m, src)
+}
+func (m *SubmitRequest) XXX_Size() int {
+	return xxx_messageInfo_SubmitRequest.Size(m)
+}
+func (m *SubmitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitRequest proto.InternalMessageInfo
+
+func (m *SubmitRequest) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *SubmitRequest) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *SubmitRequest) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type SubmitResponse struct {
+	TxId                 string    `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Result               []byte    `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Status               *TxStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SubmitResponse) Reset()         { *m = SubmitResponse{} }
+func (m *SubmitResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmitResponse) ProtoMessage()    {}
+func (*SubmitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6b9b1971fdd663a, []int{4}
+}
+
+func (m *SubmitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitResponse.Unmarshal(m, b)
+}
+func (m *SubmitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitResponse.Marshal(b, m, deterministic)
+}
+func (m *SubmitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitResponse.Merge(m, src)
+}
+func (m *SubmitResponse) XXX_Size() int {
+	return xxx_messageInfo_SubmitResponse.Size(m)
+}
+func (m *SubmitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitResponse proto.InternalMessageInfo
+
+func (m *SubmitResponse) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *SubmitResponse) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *SubmitResponse) GetStatus() *TxStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type SubmitAsyncResponse struct {
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// result is the result of the endorsement.
+	Result               []byte   `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubmitAsyncResponse) Reset()         { *m = SubmitAsyncResponse{} }
+func (m *SubmitAsyncResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmitAsyncResponse) ProtoMessage()    {}
+func (*SubmitAsyncResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6b9b1971fdd663a, []int{5}
+}
+
+func (m *SubmitAsyncResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitAsyncResponse.Unmarshal(m, b)
+}
+func (m *SubmitAsyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitAsyncResponse.Marshal(b, m, deterministic)
+}
+func (m *SubmitAsyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitAsyncResponse.Merge(m, src)
+}
+func (m *SubmitAsyncResponse) XXX_Size() int {
+	return xxx_messageInfo_SubmitAsyncResponse.Size(m)
+}
+func (m *SubmitAsyncResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitAsyncResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitAsyncResponse proto.InternalMessageInfo
+
+func (m *SubmitAsyncResponse) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *SubmitAsyncResponse) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type CommitStatusRequest struct {
+	TxId                 string   `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitStatusRequest) Reset()         { *m = CommitStatusRequest{} }
+func (m *CommitStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitStatusRequest) ProtoMessage()    {}
+func (*CommitStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6b9b1971fdd663a, []int{6}
+}
+
+func (m *CommitStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommitStatusRequest.Unmarshal(m, b)
+}
+func (m *CommitStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommitStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *CommitStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitStatusRequest.Merge(m, src)
+}
+func (m *CommitStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_CommitStatusRequest.Size(m)
+}
+func (m *CommitStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitStatusRequest proto.InternalMessageInfo
+
+func (m *CommitStatusRequest) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+// TxStatus is the outcome of the commit of a transaction.
+type TxStatus struct {
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// block_number is not reported for the transactions looked up in the ledger.
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// validation_code is the name of the validation code, e.g., "VALID" or
+	// "MVCC_READ_CONFLICT".
+	ValidationCode       string   `protobuf:"bytes,3,opt,name=validation_code,json=validationCode,proto3" json:"validation_code,omitempty"`
+	Valid                bool     `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxStatus) Reset()         { *m = TxStatus{} }
+func (m *TxStatus) String() string { return proto.CompactTextString(m) }
+func (*TxStatus) ProtoMessage()    {}
+func (*TxStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6b9b1971fdd663a, []int{7}
+}
+
+func (m *TxStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxStatus.Unmarshal(m, b)
+}
+func (m *TxStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxStatus.Marshal(b, m, deterministic)
+}
+func (m *TxStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatus.Merge(m, src)
+}
+func (m *TxStatus) XXX_Size() int {
+	return xxx_messageInfo_TxStatus.Size(m)
+}
+func (m *TxStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxStatus proto.InternalMessageInfo
+
+func (m *TxStatus) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *TxStatus) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *TxStatus) GetValidationCode() string {
+	if m != nil {
+		return m.ValidationCode
+	}
+	return ""
+}
+
+func (m *TxStatus) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+type ChaincodeEventsRequest struct {
+	Target *Target `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// filter is a regular expression on the event names.  Empty matches every
+	// event.
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// start_block is the first block of the stream.  Zero starts with the newest
+	// block.
+	StartBlock           uint64   `protobuf:"varint,3,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChaincodeEventsRequest) Reset()         { *m = ChaincodeEventsRequest{} }
+func (m *ChaincodeEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ChaincodeEventsRequest) ProtoMessage()    {}
+func (*ChaincodeEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6b9b1971fdd663a, []int{8}
+}
+
+func (m *ChaincodeEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChaincodeEventsRequest.Unmarshal(m, b)
+}
+func (m *ChaincodeEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChaincodeEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *ChaincodeEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChaincodeEventsRequest.Merge(m, src)
+}
+func (m *ChaincodeEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ChaincodeEventsRequest.Size(m)
+}
+func (m *ChaincodeEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChaincodeEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChaincodeEventsRequest proto.InternalMessageInfo
+
+func (m *ChaincodeEventsRequest) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *ChaincodeEventsRequest) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+func (m *ChaincodeEventsRequest) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+// ChaincodeEvent is an event set by a chaincode during a transaction.
+type ChaincodeEvent struct {
+	Chaincode            string   `protobuf:"bytes,1,opt,name=chaincode,proto3" json:"chaincode,omitempty"`
+	TxId                 string   `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Payload              []byte   `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	BlockNumber          uint64   `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChaincodeEvent) Reset()         { *m = ChaincodeEvent{} }
+func (m *ChaincodeEvent) String() string { return proto.CompactTextString(m) }
+func (*ChaincodeEvent) ProtoMessage()    {}
+func (*ChaincodeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6b9b1971fdd663a, []int{9}
+}
+
+func (m *ChaincodeEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChaincodeEvent.Unmarshal(m, b)
+}
+func (m *ChaincodeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChaincodeEvent.Marshal(b, m, deterministic)
+}
+func (m *ChaincodeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChaincodeEvent.Merge(m, src)
+}
+func (m *ChaincodeEvent) XXX_Size() int {
+	return xxx_messageInfo_ChaincodeEvent.Size(m)
+}
+func (m *ChaincodeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChaincodeEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChaincodeEvent proto.InternalMessageInfo
+
+func (m *ChaincodeEvent) GetChaincode() string {
+	if m != nil {
+		return m.Chaincode
+	}
+	return ""
+}
+
+func (m *ChaincodeEvent) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *ChaincodeEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ChaincodeEvent) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *ChaincodeEvent) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Target)(nil), "ledger.v1.Target")
+	proto.RegisterType((*EvaluateRequest)(nil), "ledger.v1.EvaluateRequest")
+	proto.RegisterType((*EvaluateResponse)(nil), "ledger.v1.EvaluateResponse")
+	proto.RegisterType((*SubmitRequest)(nil), "ledger.v1.SubmitRequest")
+	proto.RegisterType((*SubmitResponse)(nil), "ledger.v1.SubmitResponse")
+	proto.RegisterType((*SubmitAsyncResponse)(nil), "ledger.v1.SubmitAsyncResponse")
+	proto.RegisterType((*CommitStatusRequest)(nil), "ledger.v1.CommitStatusRequest")
+	proto.RegisterType((*TxStatus)(nil), "ledger.v1.TxStatus")
+	proto.RegisterType((*ChaincodeEventsRequest)(nil), "ledger.v1.ChaincodeEventsRequest")
+	proto.RegisterType((*ChaincodeEvent)(nil), "ledger.v1.ChaincodeEvent")
+}
+
+func init() { proto.RegisterFile("ledgerpb/ledger.proto", fileDescriptor_f6b9b1971fdd663a) }
+
+var fileDescriptor_f6b9b1971fdd663a = []byte{
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xcf, 0x8f, 0xd2, 0x40,
+	0x14, 0x4e, 0xa1, 0x54, 0x78, 0x20, 0xe8, 0xe0, 0x92, 0x6e, 0x35, 0xca, 0xf6, 0x22, 0xae, 0x09,
+	0xab, 0x78, 0xf6, 0x20, 0x64, 0x63, 0x4c, 0x8c, 0x89, 0xb3, 0x9e, 0x8c, 0x09, 0x19, 0xca, 0x2c,
+	0xdb, 0xb5, 0x4c, 0xb1, 0x33, 0x25, 0x6c, 0xe2, 0xc5, 0xff, 0xc1, 0x7f, 0xd3, 0xff, 0xc1, 0xf0,
+	0x3a, 0x85, 0x01, 0xaa, 0x87, 0x3d, 0xec, 0x6d, 0xde, 0x8f, 0xbe, 0xef, 0x9b, 0xaf, 0xdf, 0x1b,
+	0x38, 0x8a, 0xf8, 0x74, 0xc6, 0x93, 0xc5, 0xe4, 0x2c, 0x3b, 0xf4, 0x17, 0x49, 0xac, 0x62, 0x52,
+	0xd3, 0xd1, 0xf2, 0xb5, 0xff, 0x0d, 0x9c, 0x2f, 0x2c, 0x99, 0x71, 0x45, 0x5c, 0xb8, 0x17, 0x5c,
+	0x31, 0x21, 0x78, 0xe4, 0x5a, 0x5d, 0xab, 0x57, 0xa3, 0x79, 0x48, 0x9e, 0x40, 0x2d, 0xb8, 0x62,
+	0xa1, 0x08, 0xe2, 0x29, 0x77, 0x4b, 0x58, 0xdb, 0x26, 0x88, 0x07, 0xd5, 0x20, 0x16, 0x2a, 0x61,
+	0x81, 0x72, 0xcb, 0x58, 0xdc, 0xc4, 0x7e, 0x04, 0xad, 0xf3, 0x25, 0x8b, 0x52, 0xa6, 0x38, 0xe5,
+	0x3f, 0x52, 0x2e, 0x15, 0x79, 0x01, 0x8e, 0x42, 0x40, 0x44, 0xa9, 0x0f, 0x1e, 0xf6, 0x37, 0x64,
+	0xfa, 0x19, 0x13, 0xaa, 0x1b, 0xd6, 0x93, 0x2f, 0x53, 0x11, 0xa8, 0x30, 0x16, 0x1a, 0x76, 0x13,
+	0x13, 0x02, 0x36, 0x4b, 0x66, 0xd2, 0x2d, 0x77, 0xcb, 0xbd, 0x1a, 0xc5, 0xb3, 0x7f, 0x0a, 0x0f,
+	0xb6, 0x68, 0x72, 0x11, 0x0b, 0xc9, 0x49, 0x07, 0x9c, 0x84, 0xcb, 0x34, 0xca, 0xe0, 0x1a, 0x54,
+	0x47, 0xfe, 0x35, 0xdc, 0xbf, 0x48, 0x27, 0xf3, 0x50, 0xdd, 0x01, 0xaf, 0x6b, 0x68, 0xe6, 0x58,
+	0x9a, 0x55, 0x1b, 0x2a, 0x6a, 0x35, 0x0e, 0xa7, 0x5a, 0x69, 0x5b, 0xad, 0x3e, 0x4c, 0x0d, 0xaa,
+	0x25, 0x93, 0x2a, 0x79, 0x09, 0x8e, 0x54, 0x4c, 0xa5, 0x12, 0xe5, 0xad, 0x0f, 0xda, 0x26, 0xb3,
+	0xd5, 0x05, 0x96, 0xa8, 0x6e, 0xf1, 0x87, 0xd0, 0xce, 0xb0, 0xde, 0xc9, 0x1b, 0x11, 0xdc, 0x0a,
+	0xd0, 0x3f, 0x85, 0xf6, 0x28, 0x9e, 0xcf, 0x43, 0xa5, 0x67, 0x6b, 0x85, 0x8a, 0x66, 0xf8, 0xbf,
+	0x2c, 0xa8, 0xe6, 0x24, 0x8a, 0x51, 0x4e, 0xa0, 0x31, 0x89, 0xe2, 0xe0, 0xfb, 0x58, 0xa4, 0xf3,
+	0x09, 0x4f, 0x10, 0xcb, 0xa6, 0x75, 0xcc, 0x7d, 0xc2, 0x14, 0x79, 0x0e, 0xad, 0x25, 0x8b, 0xc2,
+	0x29, 0x5b, 0x4b, 0x38, 0x46, 0x9b, 0x65, 0x4e, 0x6a, 0x6e, 0xd3, 0xa3, 0xb5, 0xd7, 0x1e, 0x41,
+	0x05, 0x33, 0xae, 0xdd, 0xb5, 0x7a, 0x55, 0x9a, 0x05, 0xfe, 0x4f, 0xe8, 0x8c, 0x72, 0x3b, 0x9e,
+	0x2f, 0xb9, 0x50, 0xf2, 0x16, 0x3f, 0xb5, 0x03, 0xce, 0x65, 0x18, 0x29, 0x4d, 0xb0, 0x46, 0x75,
+	0x44, 0x9e, 0x41, 0x5d, 0x2a, 0x96, 0xa8, 0x31, 0x12, 0x46, 0x5e, 0x36, 0x05, 0x4c, 0x0d, 0xd7,
+	0x19, 0xff, 0xb7, 0x05, 0xcd, 0x5d, 0xf8, 0xdd, 0x85, 0xb1, 0xf6, 0x17, 0x66, 0xa3, 0x52, 0xc9,
+	0x50, 0x89, 0x80, 0x2d, 0xd8, 0x3c, 0xbf, 0x37, 0x9e, 0xd7, 0x1b, 0xb9, 0x60, 0x37, 0x51, 0xcc,
+	0xb2, 0xfb, 0x36, 0x68, 0x1e, 0x1e, 0x68, 0x5a, 0x39, 0xd0, 0x74, 0xf0, 0xa7, 0x04, 0xce, 0x47,
+	0xbc, 0x2c, 0x19, 0x41, 0x35, 0xdf, 0x0b, 0xe2, 0x19, 0x0a, 0xec, 0xad, 0xa6, 0xf7, 0xb8, 0xb0,
+	0xa6, 0x1d, 0xf4, 0x16, 0x9c, 0xcc, 0x58, 0xc4, 0x35, 0xda, 0x76, 0x76, 0xc8, 0x3b, 0x2e, 0xa8,
+	0xe8, 0xcf, 0xdf, 0x43, 0xdd, 0xf0, 0xe5, 0x7f, 0x66, 0x3c, 0x3d, 0xa8, 0xec, 0x3a, 0x79, 0x04,
+	0x0d, 0xd3, 0x9c, 0xc4, 0xec, 0x2f, 0x70, 0xad, 0x57, 0xb4, 0x2d, 0xe4, 0x33, 0xb4, 0xf6, 0x1c,
+	0x43, 0x4e, 0xcc, 0x39, 0x85, 0x6e, 0xf2, 0x8e, 0xff, 0xd9, 0xf2, 0xca, 0x1a, 0x1e, 0x7d, 0x6d,
+	0xa3, 0xfc, 0xf8, 0x9f, 0xcf, 0xf2, 0x77, 0x77, 0xe2, 0xe0, 0x8b, 0xfb, 0xe6, 0xef, 0x00, 0xca,
+	0x45, 0x24, 0xaa, 0x8a, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// LedgerClient is the client API for Ledger service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LedgerClient interface {
+	// Evaluate evaluates a transaction on a peer without ordering it.
+	Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error)
+	// Submit submits a transaction and waits for its commit.  An invalid
+	// transaction fails with the code ABORTED.
+	Submit(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*SubmitResponse, error)
+	// SubmitAsync submits a transaction and returns once the orderer accepted it.
+	SubmitAsync(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*SubmitAsyncResponse, error)
+	// CommitStatus waits for the commit of a transaction sent by SubmitAsync, or
+	// looks it up in the ledger.  It fails with the code NOT_FOUND if the
+	// transaction is unknown.
+	CommitStatus(ctx context.Context, in *CommitStatusRequest, opts ...grpc.CallOption) (*TxStatus, error)
+	// ChaincodeEvents streams the events of a chaincode until the call is
+	// cancelled.
+	ChaincodeEvents(ctx context.Context, in *ChaincodeEventsRequest, opts ...grpc.CallOption) (Ledger_ChaincodeEventsClient, error)
+}
+
+type ledgerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLedgerClient(cc grpc.ClientConnInterface) LedgerClient {
+	return &ledgerClient{cc}
+}
+
+func (c *ledgerClient) Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error) {
+	out := new(EvaluateResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1.Ledger/Evaluate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) Submit(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*SubmitResponse, error) {
+	out := new(SubmitResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1.Ledger/Submit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) SubmitAsync(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*SubmitAsyncResponse, error) {
+	out := new(SubmitAsyncResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1.Ledger/SubmitAsync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) CommitStatus(ctx context.Context, in *CommitStatusRequest, opts ...grpc.CallOption) (*TxStatus, error) {
+	out := new(TxStatus)
+	err := c.cc.Invoke(ctx, "/ledger.v1.Ledger/CommitStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerClient) ChaincodeEvents(ctx context.Context, in *ChaincodeEventsRequest, opts ...grpc.CallOption) (Ledger_ChaincodeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Ledger_serviceDesc.Streams[0], "/ledger.v1.Ledger/ChaincodeEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ledgerChaincodeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ledger_ChaincodeEventsClient interface {
+	Recv() (*ChaincodeEvent, error)
+	grpc.ClientStream
+}
+
+type ledgerChaincodeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *ledgerChaincodeEventsClient) Recv() (*ChaincodeEvent, error) {
+	m := new(ChaincodeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// LedgerServer is the server API for Ledger service.
+type LedgerServer interface {
+	// Evaluate evaluates a transaction on a peer without ordering it.
+	Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error)
+	// Submit submits a transaction and waits for its commit.  An invalid
+	// transaction fails with the code ABORTED.
+	Submit(context.Context, *SubmitRequest) (*SubmitResponse, error)
+	// SubmitAsync submits a transaction and returns once the orderer accepted it.
+	SubmitAsync(context.Context, *SubmitRequest) (*SubmitAsyncResponse, error)
+	// CommitStatus waits for the commit of a transaction sent by SubmitAsync, or
+	// looks it up in the ledger.  It fails with the code NOT_FOUND if the
+	// transaction is unknown.
+	CommitStatus(context.Context, *CommitStatusRequest) (*TxStatus, error)
+	// ChaincodeEvents streams the events of a chaincode until the call is
+	// cancelled.
+	ChaincodeEvents(*ChaincodeEventsRequest, Ledger_ChaincodeEventsServer) error
+}
+
+// UnimplementedLedgerServer can be embedded to have forward compatible implementations.
+type UnimplementedLedgerServer struct {
+}
+
+func (*UnimplementedLedgerServer) Evaluate(ctx context.Context, req *EvaluateRequest) (*EvaluateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evaluate not implemented")
+}
+func (*UnimplementedLedgerServer) Submit(ctx context.Context, req *SubmitRequest) (*SubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Submit not implemented")
+}
+func (*UnimplementedLedgerServer) SubmitAsync(ctx context.Context, req *SubmitRequest) (*SubmitAsyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAsync not implemented")
+}
+func (*UnimplementedLedgerServer) CommitStatus(ctx context.Context, req *CommitStatusRequest) (*TxStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitStatus not implemented")
+}
+func (*UnimplementedLedgerServer) ChaincodeEvents(req *ChaincodeEventsRequest, srv Ledger_ChaincodeEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ChaincodeEvents not implemented")
+}
+
+func RegisterLedgerServer(s *grpc.Server, srv LedgerServer) {
+	s.RegisterService(&_Ledger_serviceDesc, srv)
+}
+
+func _Ledger_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1.Ledger/Evaluate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).Evaluate(ctx, req.(*EvaluateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_Submit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).Submit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1.Ledger/Submit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).Submit(ctx, req.(*SubmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_SubmitAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).SubmitAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1.Ledger/SubmitAsync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).SubmitAsync(ctx, req.(*SubmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_CommitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServer).CommitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1.Ledger/CommitStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServer).CommitStatus(ctx, req.(*CommitStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ledger_ChaincodeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChaincodeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LedgerServer).ChaincodeEvents(m, &ledgerChaincodeEventsServer{stream})
+}
+
+type Ledger_ChaincodeEventsServer interface {
+	Send(*ChaincodeEvent) error
+	grpc.ServerStream
+}
+
+type ledgerChaincodeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *ledgerChaincodeEventsServer) Send(m *ChaincodeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Ledger_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ledger.v1.Ledger",
+	HandlerType: (*LedgerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Evaluate",
+			Handler:    _Ledger_Evaluate_Handler,
+		},
+		{
+			MethodName: "Submit",
+			Handler:    _Ledger_Submit_Handler,
+		},
+		{
+			MethodName: "SubmitAsync",
+			Handler:    _Ledger_SubmitAsync_Handler,
+		},
+		{
+			MethodName: "CommitStatus",
+			Handler:    _Ledger_CommitStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ChaincodeEvents",
+			Handler:       _Ledger_ChaincodeEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ledgerpb/ledger.proto",
+}
@@ -0,0 +1,224 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Fields of the log entries of the calls.  They are the same in text and JSON
+// format.
+const (
+	LogFieldChannel   = "channel"
+	LogFieldChaincode = "chaincode"
+	LogFieldUser      = "user"
+	LogFieldTxID      = "txid"
+	LogFieldDuration  = "duration" // in seconds
+)
+
+// Formats and destinations of the log.
+const (
+	LogFormatText      = "text"
+	LogFormatJSON      = "json"
+	LogToFile          = "file"
+	LogToStderr        = "stderr"
+	LogToFileAndStderr = "both"
+)
+
+// LogConfig defines the package logger Logr.  It is the section [log] of the
+// configuration file.
+//
+//	[log]
+//	file = "bc.log"
+//	format = "json"        # "text" or "json"
+//	level = "info"         # logrus level
+//	destination = "both"   # "file", "stderr" or "both"
+//	maxSize = 100          # megabytes before rotation
+//	maxAge = 30            # days of retention of the rotated files
+//	maxBackups = 5         # number of rotated files kept
+//	compress = true        # gzip the rotated files
+type LogConfig struct {
+	File        string
+	Format      string
+	Level       string
+	Destination string
+	// MaxSize is the size in megabytes of the file before it is rotated.
+	MaxSize int
+	// MaxAge is the number of days the rotated files are retained.  Zero
+	// retains them regardless of their age.
+	MaxAge int
+	// MaxBackups is the number of rotated files retained.  Zero retains all
+	// of them.
+	MaxBackups int
+	Compress   bool
+}
+
+// DefaultLogConfig returns the configuration of Logr when the configuration
+// file has no [log] section: text to file "bc.log" at info level, rotated every
+// 100 megabytes.
+func DefaultLogConfig() LogConfig {
+	return LogConfig{
+		File:        "bc.log",
+		Format:      LogFormatText,
+		Level:       "info",
+		Destination: LogToFile,
+		MaxSize:     100,
+	}
+}
+
+// loadLogConfig reads the section [log] of `vi`.  The missing keys keep their
+// default value, except the file and the level that default to the top-level
+// keys "log" and "debug".
+func loadLogConfig(vi *viper.Viper) (LogConfig, error) {
+	lc := DefaultLogConfig()
+	if s, ok := vi.Get("log").(string); ok && s != "" {
+		lc.File = s
+	}
+	if vi.GetBool("debug") {
+		lc.Level = "debug"
+	}
+	if s := vi.GetString("log.file"); s != "" {
+		lc.File = s
+	}
+	if s := vi.GetString("log.format"); s != "" {
+		lc.Format = strings.ToLower(s)
+	}
+	if s := vi.GetString("log.level"); s != "" {
+		lc.Level = strings.ToLower(s)
+	}
+	if s := vi.GetString("log.destination"); s != "" {
+		lc.Destination = strings.ToLower(s)
+	}
+	if vi.IsSet("log.maxSize") {
+		lc.MaxSize = vi.GetInt("log.maxSize")
+	}
+	lc.MaxAge = vi.GetInt("log.maxAge")
+	lc.MaxBackups = vi.GetInt("log.maxBackups")
+	lc.Compress = vi.GetBool("log.compress")
+	return lc, lc.validate()
+}
+
+func (lc LogConfig) validate() error {
+	if _, err := logrus.ParseLevel(lc.Level); err != nil {
+		return fmt.Errorf("invalid log level %q", lc.Level)
+	}
+	switch lc.Format {
+	case LogFormatText, LogFormatJSON:
+	default:
+		return fmt.Errorf("invalid log format %q", lc.Format)
+	}
+	switch lc.Destination {
+	case LogToFile, LogToStderr, LogToFileAndStderr:
+	default:
+		return fmt.Errorf("invalid log destination %q", lc.Destination)
+	}
+	if lc.MaxSize < 0 || lc.MaxAge < 0 || lc.MaxBackups < 0 {
+		return fmt.Errorf("negative log rotation of %q", lc.File)
+	}
+	return nil
+}
+
+// logFile is the rotated file currently written by Logr.
+var logFile struct {
+	sync.Mutex
+	w *lumberjack.Logger
+}
+
+// ConfigureLog applies `lc` to the package logger Logr.  The entries already
+// written are kept; the previous log file is closed.  If the file cannot be
+// opened, Logr writes to stderr.
+func ConfigureLog(lc LogConfig) error {
+	if err := lc.validate(); err != nil {
+		return err
+	}
+	logFile.Lock()
+	defer logFile.Unlock()
+
+	var out io.Writer = os.Stderr
+	var w *lumberjack.Logger
+	if lc.Destination != LogToStderr {
+		if err := checkLogFile(lc.File); err != nil {
+			Logr.Infof("Failed to log to file %s, using default stderr", lc.File)
+		} else {
+			w = &lumberjack.Logger{
+				Filename:   lc.File,
+				MaxSize:    lc.MaxSize,
+				MaxAge:     lc.MaxAge,
+				MaxBackups: lc.MaxBackups,
+				LocalTime:  true,
+				Compress:   lc.Compress,
+			}
+			out = w
+			if lc.Destination == LogToFileAndStderr {
+				out = io.MultiWriter(w, os.Stderr)
+			}
+		}
+	}
+	level, _ := logrus.ParseLevel(lc.Level)
+	Logr.SetOutput(out)
+	Logr.SetLevel(level)
+	Logr.SetFormatter(logFormatter(lc.Format))
+	if logFile.w != nil {
+		logFile.w.Close()
+	}
+	logFile.w = w
+	return nil
+}
+
+// checkLogFile verifies that the log file `name` can be written.
+func checkLogFile(name string) error {
+	if dir := filepath.Dir(name); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	f, err := os.OpenFile(name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func logFormatter(format string) logrus.Formatter {
+	if format == LogFormatJSON {
+		return &logrus.JSONFormatter{
+			TimestampFormat: time.RFC3339Nano,
+			FieldMap:        logrus.FieldMap{logrus.FieldKeyMsg: "message"},
+		}
+	}
+	return &logrus.TextFormatter{}
+}
+
+// callLog returns the log entry of a call of `fn` on `ct`.
+func (c *Client) callLog(ct *Contract, fn string) *logrus.Entry {
+	f := logrus.Fields{LogFieldChannel: ct.channel, LogFieldChaincode: ct.chaincode, "fn": fn}
+	if c.cfg != nil {
+		f[LogFieldUser] = c.cfg.User
+	}
+	return Logr.WithFields(f)
+}
+
+// logCall logs the end of the call of `entry` that started at `start`.
+func logCall(entry *logrus.Entry, txID string, start time.Time, err error) {
+	entry = entry.WithField(LogFieldDuration, time.Since(start).Seconds())
+	if txID != "" {
+		entry = entry.WithField(LogFieldTxID, txID)
+	}
+	if err != nil {
+		entry.WithError(err).Error("call failed")
+		return
+	}
+	entry.Debug("call done")
+}
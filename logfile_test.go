@@ -0,0 +1,142 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Configuration_Load_Log(t *testing.T) {
+	require := require.New(t)
+	defer ConfigureLog(DefaultLogConfig())
+
+	dir, err := ioutil.TempDir("", "log")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	file := filepath.ToSlash(filepath.Join(dir, "app.log"))
+	cp := loadTestConfig(t, `
+[log]
+file = "`+file+`"
+format = "JSON"
+level = "debug"
+maxSize = 10
+maxBackups = 3
+compress = true
+`)
+	require.Equal(LogConfig{File: file, Format: LogFormatJSON, Level: "debug", Destination: LogToFile,
+		MaxSize: 10, MaxBackups: 3, Compress: true}, cp.Log)
+
+	f := &fakeContract{}
+	c := fakeClient(f)
+	_, err = c.Invoke("createCar", "CAR1")
+	require.NoError(err)
+	f.err = errors.New("boom")
+	_, err = c.Query("queryCar", "CAR1")
+	require.Error(err)
+
+	entries := readJSONLog(t, file)
+	require.True(len(entries) >= 4)
+	done := entries[len(entries)-3]
+	require.Equal("call done", done["message"])
+	require.Equal("mychannel", done[LogFieldChannel])
+	require.Equal("fabcar", done[LogFieldChaincode])
+	require.Equal("user1", done[LogFieldUser])
+	require.Equal("createCar", done["fn"])
+	require.Contains(done, LogFieldDuration)
+	failed := entries[len(entries)-1]
+	require.Equal("call failed", failed["message"])
+	require.Equal("error", failed["level"])
+	require.Equal("boom", failed["error"])
+}
+
+func Test_loadLogConfig_TopLevel(t *testing.T) {
+	vi := viper.New()
+	vi.SetConfigType("toml")
+	require.NoError(t, vi.ReadConfig(strings.NewReader("debug = true\nlog = \"app.log\"\n")))
+	lc, err := loadLogConfig(vi)
+	require.NoError(t, err)
+	require.Equal(t, "app.log", lc.File)
+	require.Equal(t, "debug", lc.Level)
+}
+
+func Test_Configuration_Load_Log_Invalid(t *testing.T) {
+	for _, toml := range []string{"[log]\nformat = \"xml\"\n", "[log]\ndestination = \"syslog\"\n",
+		"[log]\nlevel = \"loud\"\n", "[log]\nmaxAge = -1\n"} {
+		vi := viper.New()
+		vi.SetConfigType("toml")
+		require.NoError(t, vi.ReadConfig(strings.NewReader(toml)))
+		_, err := loadLogConfig(vi)
+		require.Error(t, err, toml)
+	}
+}
+
+func Test_ConfigureLog_Rotation(t *testing.T) {
+	require := require.New(t)
+	defer ConfigureLog(DefaultLogConfig())
+
+	dir, err := ioutil.TempDir("", "log")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	lc := DefaultLogConfig()
+	lc.File = filepath.Join(dir, "sub", "bc.log")
+	lc.MaxSize = 1
+	lc.MaxBackups = 1
+	lc.Compress = true
+	require.NoError(ConfigureLog(lc))
+
+	line := strings.Repeat("x", 64*1024)
+	for i := 0; i < 20; i++ {
+		Logr.Info(line)
+	}
+	require.Eventually(func() bool {
+		gz, _ := filepath.Glob(filepath.Join(dir, "sub", "bc-*.log.gz"))
+		return len(gz) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	st, err := os.Stat(lc.File)
+	require.NoError(err)
+	require.True(st.Size() <= 1024*1024)
+}
+
+func Test_ConfigureLog_Stderr(t *testing.T) {
+	require := require.New(t)
+	defer ConfigureLog(DefaultLogConfig())
+
+	lc := DefaultLogConfig()
+	lc.Destination = LogToStderr
+	lc.Level = "warn"
+	require.NoError(ConfigureLog(lc))
+	require.Equal(os.Stderr, Logr.Out)
+	require.Equal("warning", Logr.GetLevel().String())
+
+	lc.Destination = "both"
+	lc.Format = "yaml"
+	require.Error(ConfigureLog(lc))
+}
+
+// readJSONLog returns the entries of the JSON log file `name`.
+func readJSONLog(t *testing.T, name string) []map[string]interface{} {
+	f, err := os.Open(name)
+	require.NoError(t, err)
+	defer f.Close()
+	var entries []map[string]interface{}
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var e map[string]interface{}
+		require.NoError(t, json.Unmarshal(sc.Bytes(), &e), sc.Text())
+		entries = append(entries, e)
+	}
+	return entries
+}
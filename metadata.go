@@ -0,0 +1,344 @@
+// v0.2.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// MetadataFunction is the system transaction of the contract API returning the
+// metadata of a chaincode.
+const MetadataFunction = systemContract + ":GetMetadata"
+
+// systemContract is the contract of the contract API serving the metadata.
+const systemContract = "org.hyperledger.fabric"
+
+// Metadata describes the contracts of a chaincode as returned by the
+// `org.hyperledger.fabric:GetMetadata` transaction of the contract API.
+type Metadata struct {
+	Info       *InfoMetadata                `json:"info,omitempty"`
+	Contracts  map[string]*ContractMetadata `json:"contracts"`
+	Components ComponentMetadata            `json:"components"`
+}
+
+// InfoMetadata is the general information of a chaincode or of a contract.
+type InfoMetadata struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version,omitempty"`
+}
+
+// ContractMetadata describes one contract of a chaincode.
+type ContractMetadata struct {
+	Info         *InfoMetadata          `json:"info,omitempty"`
+	Name         string                 `json:"name"`
+	Transactions []*TransactionMetadata `json:"transactions"`
+	// Default is true for the contract whose transactions can be called without
+	// the prefix "contractName:".
+	Default bool `json:"default,omitempty"`
+}
+
+// TransactionMetadata describes one transaction of a contract.
+type TransactionMetadata struct {
+	Name       string               `json:"name"`
+	Tags       []string             `json:"tag,omitempty"`
+	Parameters []*ParameterMetadata `json:"parameters,omitempty"`
+	Returns    *ReturnMetadata      `json:"returns,omitempty"`
+}
+
+// ParameterMetadata describes one parameter of a transaction.
+type ParameterMetadata struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// ReturnMetadata describes the value returned by a transaction.
+type ReturnMetadata struct {
+	Description string  `json:"description,omitempty"`
+	Schema      *Schema `json:"schema,omitempty"`
+}
+
+// UnmarshalJSON accepts also an array of return descriptions, keeping the first
+// one, as some contract API implementations produce.
+func (r *ReturnMetadata) UnmarshalJSON(data []byte) error {
+	type plain ReturnMetadata
+	var a []plain
+	if err := json.Unmarshal(data, &a); err == nil {
+		if len(a) > 0 {
+			*r = ReturnMetadata(a[0])
+		}
+		return nil
+	}
+	return json.Unmarshal(data, (*plain)(r))
+}
+
+// ComponentMetadata holds the schemas of the complex types used by the
+// transactions.
+type ComponentMetadata struct {
+	Schemas map[string]*Schema `json:"schemas,omitempty"`
+}
+
+// Schema is the subset of JSON schema used by the contract API metadata.
+type Schema struct {
+	ID                   string             `json:"$id,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 SchemaType         `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties interface{}        `json:"additionalProperties,omitempty"`
+}
+
+// SchemaType is the type of a JSON schema.  JSON schema accepts either a string
+// or an array of strings.
+type SchemaType []string
+
+// UnmarshalJSON accepts a string or an array of strings.
+func (st *SchemaType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*st = SchemaType{s}
+		return nil
+	}
+	var a []string
+	if err := json.Unmarshal(data, &a); err != nil {
+		return err
+	}
+	*st = a
+	return nil
+}
+
+// MarshalJSON returns a string if the type is unique.
+func (st SchemaType) MarshalJSON() ([]byte, error) {
+	if len(st) == 1 {
+		return json.Marshal(st[0])
+	}
+	return json.Marshal([]string(st))
+}
+
+// Main returns the first type that is not "null", or "" if none.
+func (st SchemaType) Main() string {
+	for _, t := range st {
+		if t != "null" {
+			return t
+		}
+	}
+	return ""
+}
+
+// RefName returns the name of the component referenced by the schema, or "" if
+// the schema is not a reference.
+func (s *Schema) RefName() string {
+	if s == nil || s.Ref == "" {
+		return ""
+	}
+	return s.Ref[strings.LastIndex(s.Ref, "/")+1:]
+}
+
+// ParseMetadata decodes the JSON metadata `data`.  Contracts without name get
+// the key under which they are listed.
+func ParseMetadata(data []byte) (*Metadata, error) {
+	md := &Metadata{}
+	if err := json.Unmarshal(data, md); err != nil {
+		return nil, &DecodeError{Fn: MetadataFunction, Payload: data, Err: err}
+	}
+	for name, ct := range md.Contracts {
+		if ct.Name == "" {
+			ct.Name = name
+		}
+	}
+	return md, nil
+}
+
+// IsSubmit returns true if the transaction updates the ledger, i.e., it should be
+// submitted rather than evaluated.  Transactions tagged neither as submit nor as
+// evaluate are considered as submit.
+func (t *TransactionMetadata) IsSubmit() bool {
+	for _, tag := range t.Tags {
+		switch strings.ToLower(tag) {
+		case "submit", "submittx":
+			return true
+		case "evaluate", "evaluatetx":
+			return false
+		}
+	}
+	return true
+}
+
+// QualifiedName returns the name of transaction `tx` of the contract as used
+// when invoking the chaincode.  The transactions of the default contract do not
+// need the contract prefix.
+func (ct *ContractMetadata) QualifiedName(tx string) string {
+	if ct.Default {
+		return tx
+	}
+	return ct.Name + ":" + tx
+}
+
+// Metadata queries and parses the metadata of the chaincode of the Client.
+func (c *Client) Metadata() (*Metadata, error) {
+	if !c.initialized {
+		return nil, ErrClientNotInitialized
+	}
+	// the system contract is not reachable through a named contract.
+	ct, err := c.Contract(c.cfg.ChannelID, c.cfg.ChainCodeID)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ct.Query(MetadataFunction)
+	if err != nil {
+		Logr.Errorf("could not query metadata: %v", err)
+		return nil, err
+	}
+	return ParseMetadata(data)
+}
+
+// RequireFunctions checks that the chaincode of the Client exposes the
+// transactions `names`.  It is meant to fail fast at startup.  The syntax of a
+// name is described by Metadata.Require.
+func (c *Client) RequireFunctions(names ...string) error {
+	md, err := c.Metadata()
+	if err != nil {
+		return err
+	}
+	return md.Require(names...)
+}
+
+// Require checks that the transactions `names` exist.  A name may be qualified
+// by its contract, e.g., "AuditContract:Record", else it belongs to the default
+// contract.  It may also define the expected types of the parameters and of the
+// returned value, e.g., "QueryCar(string) Car".  Types are the JSON schema types
+// "string", "integer", "number", "boolean", "object", the name of a component,
+// or "[]" followed by a type for arrays.  It returns ErrFunctionMismatch
+// describing every mismatch.
+func (md *Metadata) Require(names ...string) error {
+	var problems []string
+	for _, name := range names {
+		if err := md.require(name); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrFunctionMismatch, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// require checks the single transaction `sig`.
+func (md *Metadata) require(sig string) error {
+	name, params, ret, hasParams := parseSignature(sig)
+	_, tx := md.Transaction(name)
+	if tx == nil {
+		return fmt.Errorf("%s is missing", name)
+	}
+	if !hasParams {
+		return nil
+	}
+	var actual []string
+	for _, p := range tx.Parameters {
+		actual = append(actual, p.Schema.TypeName())
+	}
+	if strings.Join(actual, ",") != strings.Join(params, ",") {
+		return fmt.Errorf("%s expects (%s) not (%s)", name, strings.Join(actual, ","), strings.Join(params, ","))
+	}
+	var actualRet string
+	if tx.Returns != nil {
+		actualRet = tx.Returns.Schema.TypeName()
+	}
+	if ret != actualRet {
+		return fmt.Errorf("%s returns %q not %q", name, actualRet, ret)
+	}
+	return nil
+}
+
+// Transaction returns the transaction `name` and its contract.  `name` may be
+// qualified by the contract name.  Unqualified names belong to the default
+// contract, or to the only contract if none is flagged as default.  It returns
+// nil if the transaction does not exist.
+func (md *Metadata) Transaction(name string) (*ContractMetadata, *TransactionMetadata) {
+	var ct *ContractMetadata
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		ct = md.Contracts[name[:i]]
+		name = name[i+1:]
+	} else {
+		ct = md.defaultContract()
+	}
+	if ct == nil {
+		return nil, nil
+	}
+	for _, tx := range ct.Transactions {
+		if tx.Name == name {
+			return ct, tx
+		}
+	}
+	return ct, nil
+}
+
+// defaultContract returns the default contract of the chaincode.
+func (md *Metadata) defaultContract() *ContractMetadata {
+	var single *ContractMetadata
+	n := 0
+	for name, ct := range md.Contracts {
+		if ct.Default {
+			return ct
+		}
+		if name != systemContract {
+			single = ct
+			n++
+		}
+	}
+	if n == 1 {
+		return single
+	}
+	return nil
+}
+
+// TypeName returns the name of the type of the schema as used by
+// Metadata.Require.
+func (s *Schema) TypeName() string {
+	if s == nil {
+		return ""
+	}
+	if ref := s.RefName(); ref != "" {
+		return ref
+	}
+	t := s.Type.Main()
+	if t == "array" {
+		return "[]" + s.Items.TypeName()
+	}
+	if t == "object" && s.ID != "" {
+		return s.ID
+	}
+	return t
+}
+
+// parseSignature splits `sig` of the form "name(type1,type2) ret" into its
+// components.  `hasParams` is false if `sig` has no parenthesis.
+func parseSignature(sig string) (name string, params []string, ret string, hasParams bool) {
+	sig = strings.TrimSpace(sig)
+	open := strings.Index(sig, "(")
+	if open < 0 {
+		return sig, nil, "", false
+	}
+	name = strings.TrimSpace(sig[:open])
+	rest := sig[open+1:]
+	end := strings.Index(rest, ")")
+	if end < 0 {
+		end = len(rest)
+	} else {
+		ret = strings.TrimSpace(rest[end+1:])
+	}
+	for _, p := range strings.Split(rest[:end], ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			params = append(params, p)
+		}
+	}
+	return name, params, ret, true
+}
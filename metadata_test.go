@@ -0,0 +1,62 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testMetadata(t *testing.T) *Metadata {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "metadata.json"))
+	require.NoError(t, err)
+	md, err := ParseMetadata(data)
+	require.NoError(t, err)
+	return md
+}
+
+func Test_ParseMetadata(t *testing.T) {
+	md := testMetadata(t)
+	require.Len(t, md.Contracts, 3)
+	ct, tx := md.Transaction("QueryCar")
+	require.NotNil(t, tx)
+	require.Equal(t, "SmartContract", ct.Name)
+	require.False(t, tx.IsSubmit())
+	require.Equal(t, "Car", tx.Returns.Schema.TypeName())
+	_, tx = md.Transaction("AuditContract:Record")
+	require.NotNil(t, tx)
+	require.True(t, tx.IsSubmit())
+	_, tx = md.Transaction("Record")
+	require.Nil(t, tx)
+
+	_, err := ParseMetadata([]byte("{"))
+	require.Error(t, err)
+}
+
+func Test_Metadata_Require(t *testing.T) {
+	md := testMetadata(t)
+	require.NoError(t, md.Require("CreateCar", "QueryAllCars() []QueryResult",
+		"QueryCar(string) Car", "AuditContract:Record(string, Car, number) boolean",
+		"ChangeCarOwner(string,string)"))
+
+	err := md.Require("DeleteCar", "QueryCar(integer) Car", "CountCars() string")
+	require.ErrorIs(t, err, ErrFunctionMismatch)
+	require.Contains(t, err.Error(), "DeleteCar is missing")
+	require.Contains(t, err.Error(), "QueryCar expects (string) not (integer)")
+	require.Contains(t, err.Error(), `CountCars returns "integer" not "string"`)
+}
+
+func Test_parseSignature(t *testing.T) {
+	name, params, ret, ok := parseSignature(" a:b( x , []y ) z ")
+	require.Equal(t, "a:b", name)
+	require.Equal(t, []string{"x", "[]y"}, params)
+	require.Equal(t, "z", ret)
+	require.True(t, ok)
+	_, _, _, ok = parseSignature("fn")
+	require.False(t, ok)
+}
@@ -0,0 +1,211 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"time"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/gateway"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// metricsNamespace prefixes the names of the metrics.
+const metricsNamespace = "fabric_client"
+
+// Outcomes of a call.
+const (
+	outcomeOK       = "ok"
+	outcomeError    = "error"
+	outcomeRejected = "rejected" // by the circuit breaker or the rate limiter
+)
+
+// clientMetrics are the Prometheus metrics of a Client.  A nil *clientMetrics
+// records nothing.
+type clientMetrics struct {
+	calls    *prometheus.CounterVec   // kind, fn, outcome
+	duration *prometheus.HistogramVec // kind, fn, phase
+	retries  *prometheus.CounterVec   // fn
+	inflight prometheus.Gauge
+	expiry   *prometheus.GaugeVec // user
+	wallet   prometheus.Gauge
+	breaker  prometheus.Gauge
+}
+
+// newClientMetrics creates the metrics and registers them in `reg`.  Metrics
+// already registered by another Client are shared.
+func newClientMetrics(reg prometheus.Registerer) (*clientMetrics, error) {
+	m := &clientMetrics{
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "calls_total",
+			Help:      "Number of transactions by kind (submit or evaluate), function and outcome.",
+		}, []string{"kind", "fn", "outcome"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "call_duration_seconds",
+			Help:      "Duration of the transactions by kind, function and phase (total, endorse, order, commit).",
+			Buckets:   []float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+		}, []string{"kind", "fn", "phase"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "retries_total",
+			Help:      "Number of retried attempts by function.",
+		}, []string{"fn"}),
+		inflight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "inflight_calls",
+			Help:      "Number of calls in progress.",
+		}),
+		expiry: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "cert_expiry_timestamp_seconds",
+			Help:      "Expiry date of the certificate of the wallet identities, in Unix time.",
+		}, []string{"user"}),
+		wallet: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "wallet_identities",
+			Help:      "Number of identities in the wallet.",
+		}),
+		breaker: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "breaker_state",
+			Help:      "State of the circuit breaker: 0 closed, 1 open, 2 half-open.",
+		}),
+	}
+	var err error
+	add := func(c prometheus.Collector) prometheus.Collector {
+		if err != nil {
+			return c
+		}
+		c, err = register(reg, c)
+		return c
+	}
+	m.calls = add(m.calls).(*prometheus.CounterVec)
+	m.duration = add(m.duration).(*prometheus.HistogramVec)
+	m.retries = add(m.retries).(*prometheus.CounterVec)
+	m.inflight = add(m.inflight).(prometheus.Gauge)
+	m.expiry = add(m.expiry).(*prometheus.GaugeVec)
+	m.wallet = add(m.wallet).(prometheus.Gauge)
+	m.breaker = add(m.breaker).(prometheus.Gauge)
+	if err != nil {
+		Logr.Errorf("could not register metrics: %v", err)
+		return nil, err
+	}
+	return m, nil
+}
+
+// register registers `c` in `reg`, or returns the collector already registered.
+func register(reg prometheus.Registerer, c prometheus.Collector) (prometheus.Collector, error) {
+	err := reg.Register(c)
+	if err == nil {
+		return c, nil
+	}
+	var are prometheus.AlreadyRegisteredError
+	if errors.As(err, &are) {
+		return are.ExistingCollector, nil
+	}
+	return c, err
+}
+
+// call records a call of kind `kind` of function `fn` that lasted `d`.
+func (m *clientMetrics) call(kind string, fn string, d time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	outcome := outcomeOK
+	switch {
+	case errors.Is(err, ErrCircuitOpen) || errors.Is(err, ErrRateLimited):
+		outcome = outcomeRejected
+	case err != nil:
+		outcome = outcomeError
+	}
+	m.calls.WithLabelValues(kind, fn, outcome).Inc()
+	m.duration.WithLabelValues(kind, fn, "total").Observe(d.Seconds())
+}
+
+// phase records that phase `phase` of a call of function `fn` lasted `d`.
+func (m *clientMetrics) phase(kind string, fn string, phase string, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.duration.WithLabelValues(kind, fn, phase).Observe(d.Seconds())
+}
+
+func (m *clientMetrics) retry(fn string) {
+	if m == nil {
+		return
+	}
+	m.retries.WithLabelValues(fn).Inc()
+}
+
+func (m *clientMetrics) begin() {
+	if m == nil {
+		return
+	}
+	m.inflight.Inc()
+}
+
+func (m *clientMetrics) end() {
+	if m == nil {
+		return
+	}
+	m.inflight.Dec()
+}
+
+func (m *clientMetrics) breakerState(s BreakerState) {
+	if m == nil {
+		return
+	}
+	m.breaker.Set(float64(s))
+}
+
+// observeWallet records the number of identities of `wallet` and the expiry of
+// their certificates.
+func (m *clientMetrics) observeWallet(wallet *gateway.Wallet) {
+	if m == nil || wallet == nil {
+		return
+	}
+	users, err := wallet.List()
+	if err != nil {
+		Logr.Errorf("could not list wallet: %v", err)
+		return
+	}
+	m.wallet.Set(float64(len(users)))
+	for _, u := range users {
+		id, err := wallet.Get(u)
+		if err != nil {
+			continue
+		}
+		if x, ok := id.(*gateway.X509Identity); ok {
+			if t, err := certExpiry(x.Certificate()); err == nil {
+				m.expiry.WithLabelValues(u).Set(float64(t.Unix()))
+			}
+		}
+	}
+}
+
+// certExpiry returns the end of validity of the PEM certificate `cert`.
+func certExpiry(cert string) (time.Time, error) {
+	b, _ := pem.Decode([]byte(cert))
+	if b == nil {
+		return time.Time{}, errors.New("no PEM certificate")
+	}
+	crt, err := x509.ParseCertificate(b.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return crt.NotAfter, nil
+}
+
+// WithMetrics registers the Prometheus metrics of the Client in `reg`.  The
+// Clients sharing `reg` share the metrics.
+func WithMetrics(reg prometheus.Registerer) ClientOption {
+	return func(cp *clientOptions) {
+		cp.metrics = reg
+	}
+}
@@ -0,0 +1,72 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/gateway"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_Metrics(t *testing.T) {
+	require := require.New(t)
+
+	reg := prometheus.NewRegistry()
+	m, err := newClientMetrics(reg)
+	require.NoError(err)
+	f := &fakeContract{}
+	c := fakeClient(f)
+	c.metrics = m
+
+	_, err = c.Invoke("createCar")
+	require.NoError(err)
+	_, err = c.Query("QueryCar")
+	require.NoError(err)
+	f.err = errors.New("rpc error: code = Unavailable")
+	c.retry = RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}
+	_, err = c.Query("QueryCar")
+	require.Error(err)
+	c.submitLim = newLimiter(RateLimit{Rate: 0.001})
+	c.submitLim.tokens = 0
+	_, err = c.Invoke("createCar")
+	require.ErrorIs(err, ErrRateLimited)
+
+	require.Equal(1.0, testutil.ToFloat64(m.calls.WithLabelValues("submit", "createCar", outcomeOK)))
+	require.Equal(1.0, testutil.ToFloat64(m.calls.WithLabelValues("submit", "createCar", outcomeRejected)))
+	require.Equal(1.0, testutil.ToFloat64(m.calls.WithLabelValues("evaluate", "QueryCar", outcomeOK)))
+	require.Equal(1.0, testutil.ToFloat64(m.calls.WithLabelValues("evaluate", "QueryCar", outcomeError)))
+	require.Equal(1.0, testutil.ToFloat64(m.retries.WithLabelValues("QueryCar")))
+	require.Equal(0.0, testutil.ToFloat64(m.inflight))
+
+	// a second Client shares the metrics
+	m2, err := newClientMetrics(reg)
+	require.NoError(err)
+	require.Equal(m.calls, m2.calls)
+
+	// disabled metrics
+	var none *clientMetrics
+	none.call("submit", "fn", time.Second, nil)
+	none.observeWallet(nil)
+}
+
+func Test_clientMetrics_observeWallet(t *testing.T) {
+	require := require.New(t)
+
+	wallet, err := gateway.NewFileSystemWallet(filepath.Join("testdata", "conf", "wallet"))
+	require.NoError(err)
+	m, err := newClientMetrics(prometheus.NewRegistry())
+	require.NoError(err)
+	m.observeWallet(wallet)
+	require.True(testutil.ToFloat64(m.wallet) >= 1)
+	exp, err := certExpiry(testCert(t))
+	require.NoError(err)
+	require.Equal(float64(exp.Unix()), testutil.ToFloat64(m.expiry.WithLabelValues("user1")))
+}
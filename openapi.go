@@ -0,0 +1,233 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// OpenAPI returns the OpenAPI 3.1 description of the routes of the Server for
+// the chaincode `chaincode` described by the metadata.  Each transaction is a
+// route /evaluate/{name} or /submit/{name} according to its tag.  The names of
+// the transactions of the non default contracts are qualified.  The result is
+// ready to be marshalled in JSON.
+func (md *Metadata) OpenAPI(chaincode string) map[string]interface{} {
+	info := map[string]interface{}{"title": chaincode, "version": "0.0.0"}
+	if md.Info != nil {
+		if md.Info.Title != "" {
+			info["title"] = md.Info.Title
+		}
+		if md.Info.Version != "" {
+			info["version"] = md.Info.Version
+		}
+		if md.Info.Description != "" {
+			info["description"] = md.Info.Description
+		}
+	}
+
+	schemas := map[string]interface{}{
+		"Error": map[string]interface{}{
+			"type":       "object",
+			"properties": map[string]interface{}{"error": map[string]interface{}{"type": "string"}},
+		},
+		"TxStatus": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"txId":           map[string]interface{}{"type": "string"},
+				"blockNumber":    map[string]interface{}{"type": "integer"},
+				"validationCode": map[string]interface{}{"type": "string"},
+				"valid":          map[string]interface{}{"type": "boolean"},
+			},
+		},
+		"ChaincodeEvent": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"chaincode":   map[string]interface{}{"type": "string"},
+				"txId":        map[string]interface{}{"type": "string"},
+				"name":        map[string]interface{}{"type": "string"},
+				"payload":     map[string]interface{}{"type": "string", "contentEncoding": "base64"},
+				"blockNumber": map[string]interface{}{"type": "integer"},
+			},
+		},
+	}
+	for name, s := range md.Components.Schemas {
+		schemas[name] = schemaMap(s)
+	}
+
+	paths := map[string]interface{}{
+		"/transactions/{txId}": map[string]interface{}{
+			"get": map[string]interface{}{
+				"operationId": "transactionStatus",
+				"summary":     "Returns the validation status of a transaction of the channel of the configuration.",
+				"parameters": []interface{}{map[string]interface{}{"name": "txId", "in": "path", "required": true,
+					"schema": map[string]interface{}{"type": "string"}}},
+				"responses": responses(map[string]interface{}{"200": jsonResponse("status of the transaction",
+					refSchema("TxStatus"))}, "401", "404", "502", "503"),
+			},
+		},
+		"/events": map[string]interface{}{
+			"get": map[string]interface{}{
+				"operationId": "chaincodeEvents",
+				"summary": "Streams the chaincode events as server-sent events.  The id of an event is its " +
+					"block number, and its type the event name.",
+				"parameters": append(targetParameters(),
+					map[string]interface{}{"name": "filter", "in": "query", "description": "regular expression on the event names",
+						"schema": map[string]interface{}{"type": "string"}},
+					map[string]interface{}{"name": "from", "in": "query", "description": "first block, default the newest one",
+						"schema": map[string]interface{}{"type": "integer"}},
+					map[string]interface{}{"name": "Last-Event-ID", "in": "header", "description": "resumes after a disconnection",
+						"schema": map[string]interface{}{"type": "string"}}),
+				"responses": responses(map[string]interface{}{"200": map[string]interface{}{
+					"description": "stream of ChaincodeEvent",
+					"content": map[string]interface{}{"text/event-stream": map[string]interface{}{
+						"schema": map[string]interface{}{"type": "string"}}},
+				}}, "400", "401", "502", "503"),
+			},
+		},
+	}
+
+	def := md.defaultContract()
+	var names []string
+	for name := range md.Contracts {
+		if name != systemContract {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, cname := range names {
+		ct := md.Contracts[cname]
+		for _, tx := range ct.Transactions {
+			name := tx.Name
+			if ct != def {
+				name = cname + ":" + tx.Name
+			}
+			route := "/submit/" + name
+			if !tx.IsSubmit() {
+				route = "/evaluate/" + name
+			}
+			paths[route] = map[string]interface{}{"post": operation(cname, name, tx)}
+		}
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.1.0",
+		"info":    info,
+		"servers": []interface{}{map[string]interface{}{"url": apiPrefix}},
+		"paths":   paths,
+		"components": map[string]interface{}{
+			"schemas": schemas,
+			"securitySchemes": map[string]interface{}{
+				"apiKey":    map[string]interface{}{"type": "apiKey", "in": "header", "name": apiKeyHeader},
+				"bearer":    map[string]interface{}{"type": "http", "scheme": "bearer"},
+				"mutualTLS": map[string]interface{}{"type": "mutualTLS"},
+			},
+		},
+		"security": []interface{}{
+			map[string]interface{}{"apiKey": []string{}},
+			map[string]interface{}{"bearer": []string{}},
+			map[string]interface{}{"mutualTLS": []string{}},
+		},
+	}
+}
+
+// operation returns the description of the route of transaction `tx` named
+// `name` of contract `contract`.
+func operation(contract string, name string, tx *TransactionMetadata) map[string]interface{} {
+	items := make([]interface{}, 0, len(tx.Parameters))
+	for _, p := range tx.Parameters {
+		s := schemaMap(p.Schema)
+		s["title"] = p.Name
+		if p.Description != "" {
+			s["description"] = p.Description
+		}
+		items = append(items, s)
+	}
+	args := map[string]interface{}{"type": "array", "prefixItems": items, "minItems": len(items),
+		"maxItems": len(items)}
+	body := map[string]interface{}{"type": "object", "properties": map[string]interface{}{"args": args},
+		"additionalProperties": false}
+	if len(items) > 0 {
+		body["required"] = []string{"args"}
+	}
+
+	result := map[string]interface{}{}
+	if tx.Returns != nil && tx.Returns.Schema != nil {
+		result = schemaMap(tx.Returns.Schema)
+	}
+	res := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"txId":   map[string]interface{}{"type": "string"},
+			"result": result,
+			"status": refSchema("TxStatus"),
+		},
+	}
+
+	params := targetParameters()
+	ok := map[string]interface{}{"200": jsonResponse("result of the transaction", res)}
+	codes := []string{"400", "401", "404", "429", "502", "503"}
+	if tx.IsSubmit() {
+		params = append(params, map[string]interface{}{"name": "async", "in": "query",
+			"description": "returns once the transaction is ordered, without waiting for its commit",
+			"schema":      map[string]interface{}{"type": "boolean"}})
+		ok["202"] = jsonResponse("transaction ordered, see /transactions/{txId}", res)
+		codes = append(codes, "409", "504")
+	}
+	return map[string]interface{}{
+		"operationId": strings.Replace(name, ":", "_", -1),
+		"tags":        []string{contract},
+		"parameters":  params,
+		"requestBody": map[string]interface{}{
+			"required": len(items) > 0,
+			"content":  map[string]interface{}{"application/json": map[string]interface{}{"schema": body}},
+		},
+		"responses": responses(ok, codes...),
+	}
+}
+
+// targetParameters describes the query parameters selecting the chaincode.
+func targetParameters() []interface{} {
+	var params []interface{}
+	for _, name := range []string{"channel", "chaincode", "contract"} {
+		params = append(params, map[string]interface{}{"name": name, "in": "query",
+			"description": name + ", default the one of the configuration",
+			"schema":      map[string]interface{}{"type": "string"}})
+	}
+	return params
+}
+
+// responses adds to `ok` the error responses of HTTP status `codes`.
+func responses(ok map[string]interface{}, codes ...string) map[string]interface{} {
+	for _, code := range codes {
+		ok[code] = jsonResponse("error", refSchema("Error"))
+	}
+	return ok
+}
+
+func jsonResponse(description string, schema interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"description": description,
+		"content":     map[string]interface{}{"application/json": map[string]interface{}{"schema": schema}},
+	}
+}
+
+func refSchema(name string) map[string]interface{} {
+	return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+}
+
+// schemaMap returns the schema `s` as a map.
+func schemaMap(s *Schema) map[string]interface{} {
+	m := map[string]interface{}{}
+	if s == nil {
+		return m
+	}
+	data, err := json.Marshal(s)
+	if err == nil {
+		json.Unmarshal(data, &m)
+	}
+	return m
+}
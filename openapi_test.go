@@ -0,0 +1,80 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Metadata_OpenAPI(t *testing.T) {
+	require := require.New(t)
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "metadata.json"))
+	require.NoError(err)
+	md, err := ParseMetadata(data)
+	require.NoError(err)
+
+	// round trip through JSON as a consumer would read it.
+	out, err := json.Marshal(md.OpenAPI("fabcar"))
+	require.NoError(err)
+	var doc struct {
+		OpenAPI string `json:"openapi"`
+		Info    struct {
+			Title   string `json:"title"`
+			Version string `json:"version"`
+		} `json:"info"`
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+			Parameters  []struct {
+				Name string `json:"name"`
+			} `json:"parameters"`
+			RequestBody struct {
+				Content map[string]struct {
+					Schema struct {
+						Properties struct {
+							Args struct {
+								PrefixItems []map[string]interface{} `json:"prefixItems"`
+							} `json:"args"`
+						} `json:"properties"`
+					} `json:"schema"`
+				} `json:"content"`
+			} `json:"requestBody"`
+			Responses map[string]interface{} `json:"responses"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]interface{} `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(json.Unmarshal(out, &doc))
+	require.Equal("3.1.0", doc.OpenAPI)
+	require.Equal("fabcar", doc.Info.Title)
+	require.Equal("1.0.0", doc.Info.Version)
+
+	require.Contains(doc.Paths, "/submit/CreateCar")
+	require.Contains(doc.Paths, "/evaluate/QueryCar")
+	require.Contains(doc.Paths, "/submit/AuditContract:Record")
+	require.Contains(doc.Paths, "/transactions/{txId}")
+	require.Contains(doc.Paths, "/events")
+	require.NotContains(doc.Paths, "/evaluate/GetMetadata")
+	require.NotContains(doc.Paths, "/evaluate/org.hyperledger.fabric:GetMetadata")
+
+	record := doc.Paths["/submit/AuditContract:Record"]["post"]
+	require.Equal("AuditContract_Record", record.OperationID)
+	items := record.RequestBody.Content["application/json"].Schema.Properties.Args.PrefixItems
+	require.Len(items, 3)
+	require.Equal("type", items[0]["title"])
+	require.Equal("#/components/schemas/Car", items[1]["$ref"])
+	require.Equal("number", items[2]["type"])
+	require.Contains(record.Responses, "202")
+	require.Equal("async", record.Parameters[len(record.Parameters)-1].Name)
+	require.NotContains(doc.Paths["/evaluate/QueryCar"]["post"].Responses, "202")
+
+	require.Contains(doc.Components.Schemas, "Car")
+	require.Contains(doc.Components.Schemas, "TxStatus")
+}
@@ -0,0 +1,469 @@
+// v0.2.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// Buckets of the outbox database.
+var (
+	bktOutbox      = []byte("outbox")       // seq -> OutboxEntry
+	bktOutboxQueue = []byte("outbox.queue") // seq -> nil for the queued entries
+	bktOutboxMeta  = []byte("outbox.meta")  // keyOutboxID -> ID of the outbox
+)
+
+var keyOutboxID = []byte("id")
+
+// States of an OutboxEntry.
+const (
+	// OutboxQueued means that the entry waits to be submitted.
+	OutboxQueued = "queued"
+	// OutboxSent means that the transaction of the entry was committed.
+	OutboxSent = "sent"
+	// OutboxFailed means that the chaincode rejected the transaction.  It is not
+	// submitted again.
+	OutboxFailed = "failed"
+)
+
+// OutboxEntry is a submission stored by the Outbox.
+type OutboxEntry struct {
+	Seq       uint64    `json:"seq"`
+	Fn        string    `json:"fn"`
+	Args      []string  `json:"args"`
+	State     string    `json:"state"`
+	Attempts  int       `json:"attempts"`
+	LastError string    `json:"lastError,omitempty"`
+	Payload   []byte    `json:"payload,omitempty"`
+	Created   time.Time `json:"created"`
+	Updated   time.Time `json:"updated"`
+}
+
+// Outbox queues durably in a local bbolt database the submissions that could not
+// reach the network, and replays them in order once the network is back.  The
+// delivery is at least once, unless the Client has an IdempotencyStore, in which
+// case each transaction is queued before its first submission and each entry is
+// submitted with InvokeIdempotent.  An Outbox is safe for concurrent use.
+type Outbox struct {
+	c        *Client
+	db       *bolt.DB
+	id       string // namespaces the idempotency keys of the entries
+	interval time.Duration
+	submit   func(ctx context.Context, e *OutboxEntry) ([]byte, error)
+	wake     chan struct{}
+	mu       sync.Mutex      // serializes the queuing and the replay to keep the order
+	inflight map[uint64]bool // queued entries submitted by Invoke
+}
+
+type outboxOptions struct {
+	interval time.Duration
+}
+
+// OutboxOption allows to parameterize the NewOutbox function.
+type OutboxOption func(opts *outboxOptions)
+
+// WithReplayInterval sets the wait between two replays while the network is
+// unavailable.  The default is 5 s.
+func WithReplayInterval(d time.Duration) OutboxOption {
+	return func(oo *outboxOptions) {
+		oo.interval = d
+	}
+}
+
+// NewOutbox creates the Outbox of Client `c` stored in the database file
+// `dbFile`.  The file is created if it does not exist.
+func NewOutbox(c *Client, dbFile string, opts ...OutboxOption) (*Outbox, error) {
+	oo := outboxOptions{interval: 5 * time.Second}
+	for _, opt := range opts {
+		opt(&oo)
+	}
+	db, err := bolt.Open(dbFile, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		Logr.Errorf("could not open outbox %s: %v", dbFile, err)
+		return nil, err
+	}
+	var id string
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, b := range [][]byte{bktOutbox, bktOutboxQueue, bktOutboxMeta} {
+			if _, err := tx.CreateBucketIfNotExists(b); err != nil {
+				return err
+			}
+		}
+		meta := tx.Bucket(bktOutboxMeta)
+		if v := meta.Get(keyOutboxID); v != nil {
+			id = string(v)
+			return nil
+		}
+		// a database recreated later gets another ID.
+		buf := make([]byte, 8)
+		if _, err := rand.Read(buf); err != nil {
+			return err
+		}
+		id = hex.EncodeToString(buf)
+		return meta.Put(keyOutboxID, []byte(id))
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	o := &Outbox{c: c, db: db, id: id, interval: oo.interval, wake: make(chan struct{}, 1),
+		inflight: make(map[uint64]bool)}
+	o.submit = o.submitEntry
+	return o, nil
+}
+
+// Close closes the database of the Outbox.
+func (o *Outbox) Close() error {
+	return o.db.Close()
+}
+
+// Invoke submits the transaction `fn` with the arguments `args`.  If entries are
+// queued or if the transaction did not reach the network, the transaction is
+// queued and Invoke returns ErrQueued.  The other failures are returned as by
+// Client.Invoke.  If the Client has an IdempotencyStore, the transaction is
+// queued before its submission, so that a transaction that may have reached the
+// orderer, e.g., after a commit timeout, stays queued too: the replay resolves it
+// under its idempotency key without submitting it again.  The submissions of
+// concurrent Invokes are concurrent.
+func (o *Outbox) Invoke(fn string, args ...string) ([]byte, error) {
+	if o.c.idemStore != nil {
+		return o.invokeQueued(fn, args)
+	}
+	o.mu.Lock()
+	n, err := o.Len()
+	if err != nil || n > 0 {
+		defer o.mu.Unlock()
+		if err != nil {
+			return nil, err
+		}
+		return nil, o.queue(fn, args)
+	}
+	o.mu.Unlock()
+	res, err := o.submit(context.Background(), &OutboxEntry{Fn: fn, Args: args})
+	if !shouldQueue(err) {
+		return res, err
+	}
+	Logr.Warnf("outbox: queuing %s: %v", fn, err)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return nil, o.queue(fn, args)
+}
+
+// invokeQueued queues the transaction `fn` and submits its entry, unless other
+// entries wait before it.  The replay does not submit the entry until the
+// outcome of this submission is recorded.
+func (o *Outbox) invokeQueued(fn string, args []string) ([]byte, error) {
+	o.mu.Lock()
+	n, err := o.Len()
+	if err != nil {
+		o.mu.Unlock()
+		return nil, err
+	}
+	if n > len(o.inflight) {
+		defer o.mu.Unlock()
+		return nil, o.queue(fn, args)
+	}
+	e, err := o.enqueue(fn, args)
+	if err != nil {
+		o.mu.Unlock()
+		return nil, err
+	}
+	o.inflight[e.Seq] = true
+	o.mu.Unlock()
+
+	ctx := context.Background()
+	res, err := o.submit(ctx, e)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.inflight, e.Seq)
+	defer o.notify()
+	queued, uerr := o.record(ctx, e, res, err)
+	switch {
+	case uerr != nil:
+		return nil, uerr
+	case queued:
+		return nil, fmt.Errorf("%w as entry %d: %v", ErrQueued, e.Seq, err)
+	}
+	return res, err
+}
+
+// queue queues the transaction `fn` and returns ErrQueued with its sequence
+// number.
+func (o *Outbox) queue(fn string, args []string) error {
+	seq, err := o.Enqueue(fn, args...)
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w as entry %d", ErrQueued, seq)
+}
+
+// shouldQueue returns true if `err` guarantees that the transaction did not
+// reach the network.  The other failures of a submission, e.g., a timeout, may
+// occur once the orderer received the transaction.
+func shouldQueue(err error) bool {
+	return err != nil && (IsSafeToResubmit(err) || errors.Is(err, ErrCircuitOpen) || errors.Is(err, ErrRateLimited))
+}
+
+// Enqueue queues the transaction `fn` with the arguments `args` for a later
+// submission.  It returns the sequence number of the entry.
+func (o *Outbox) Enqueue(fn string, args ...string) (uint64, error) {
+	e, err := o.enqueue(fn, args)
+	if err != nil {
+		return 0, err
+	}
+	o.notify()
+	return e.Seq, nil
+}
+
+// enqueue stores the queued entry of the transaction `fn`.
+func (o *Outbox) enqueue(fn string, args []string) (*OutboxEntry, error) {
+	now := time.Now()
+	e := &OutboxEntry{Fn: fn, Args: args, State: OutboxQueued, Created: now, Updated: now}
+	err := o.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bktOutbox)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		e.Seq = seq
+		if err := tx.Bucket(bktOutboxQueue).Put(u64(seq), nil); err != nil {
+			return err
+		}
+		return putEntry(b, e)
+	})
+	if err != nil {
+		Logr.Errorf("outbox: could not queue %s: %v", fn, err)
+		return nil, err
+	}
+	return e, nil
+}
+
+// notify wakes up Run.
+func (o *Outbox) notify() {
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run replays the queued entries in order until `ctx` is done.  When the
+// network is unavailable, it waits for the replay interval before trying again.
+// An entry rejected by the chaincode is marked failed and the replay continues.
+func (o *Outbox) Run(ctx context.Context) error {
+	for {
+		idle, err := o.replay(ctx)
+		if err != nil {
+			return err
+		}
+		wait := o.interval
+		if idle {
+			// nothing to replay until the next Enqueue.
+			wait = time.Hour
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-o.wake:
+		case <-t.C:
+		}
+		t.Stop()
+	}
+}
+
+// replay submits the queued entries until the queue is empty, returning true, or
+// until the network is unavailable, returning false.
+func (o *Outbox) replay(ctx context.Context) (bool, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for ctx.Err() == nil {
+		e, err := o.head()
+		if err != nil || e == nil {
+			return true, err
+		}
+		if o.inflight[e.Seq] {
+			// Invoke notifies once the outcome is recorded.
+			return false, nil
+		}
+		res, err := o.submit(ctx, e)
+		if _, err := o.record(ctx, e, res, err); err != nil {
+			return false, err
+		}
+		if e.State == OutboxQueued {
+			return false, nil
+		}
+	}
+	return false, nil
+}
+
+// record stores the outcome `res`, `err` of the submission of `e`.  It returns
+// true if the entry stays queued.
+func (o *Outbox) record(ctx context.Context, e *OutboxEntry, res []byte, err error) (bool, error) {
+	e.Attempts++
+	e.Updated = time.Now()
+	switch {
+	case err == nil:
+		e.State, e.Payload, e.LastError = OutboxSent, res, ""
+		Logr.Infof("outbox: entry %d %s sent", e.Seq, e.Fn)
+	case shouldQueue(err) || IsTransient(err) || errors.Is(err, ErrTxPending) || ctx.Err() != nil:
+		e.LastError = err.Error()
+		Logr.Warnf("outbox: entry %d %s delayed: %v", e.Seq, e.Fn, err)
+	default:
+		e.State, e.LastError = OutboxFailed, err.Error()
+		Logr.Errorf("outbox: entry %d %s failed: %v", e.Seq, e.Fn, err)
+	}
+	return e.State == OutboxQueued, o.update(e)
+}
+
+// submitEntry submits `e` through the Client.
+func (o *Outbox) submitEntry(ctx context.Context, e *OutboxEntry) ([]byte, error) {
+	if o.c.idemStore != nil && e.Seq != 0 {
+		return o.c.InvokeIdempotent(ctx, o.idempotencyKey(e), e.Fn, e.Args...)
+	}
+	return o.c.InvokeContext(ctx, e.Fn, e.Args...)
+}
+
+// idempotencyKey returns the idempotency key of `e`.  It is unique among the
+// outboxes sharing an IdempotencyStore.
+func (o *Outbox) idempotencyKey(e *OutboxEntry) string {
+	return fmt.Sprintf("outbox-%s-%d", o.id, e.Seq)
+}
+
+// head returns the first queued entry, or nil if the queue is empty.
+func (o *Outbox) head() (*OutboxEntry, error) {
+	var e *OutboxEntry
+	err := o.db.View(func(tx *bolt.Tx) error {
+		k, _ := tx.Bucket(bktOutboxQueue).Cursor().First()
+		if k == nil {
+			return nil
+		}
+		e = &OutboxEntry{}
+		return json.Unmarshal(tx.Bucket(bktOutbox).Get(k), e)
+	})
+	return e, err
+}
+
+// update stores `e` and removes it from the queue if it is no more queued.
+func (o *Outbox) update(e *OutboxEntry) error {
+	return o.db.Update(func(tx *bolt.Tx) error {
+		if e.State != OutboxQueued {
+			if err := tx.Bucket(bktOutboxQueue).Delete(u64(e.Seq)); err != nil {
+				return err
+			}
+		}
+		return putEntry(tx.Bucket(bktOutbox), e)
+	})
+}
+
+func putEntry(b *bolt.Bucket, e *OutboxEntry) error {
+	v, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return b.Put(u64(e.Seq), v)
+}
+
+// Len returns the number of queued entries.
+func (o *Outbox) Len() (int, error) {
+	var n int
+	err := o.db.View(func(tx *bolt.Tx) error {
+		n = tx.Bucket(bktOutboxQueue).Stats().KeyN
+		return nil
+	})
+	return n, err
+}
+
+// Entry returns the entry `seq`, or nil if it does not exist.
+func (o *Outbox) Entry(seq uint64) (*OutboxEntry, error) {
+	var e *OutboxEntry
+	err := o.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(bktOutbox).Get(u64(seq))
+		if v == nil {
+			return nil
+		}
+		e = &OutboxEntry{}
+		return json.Unmarshal(v, e)
+	})
+	return e, err
+}
+
+// Entries returns the entries in the states `states`, or all the entries if
+// none is given, in order.
+func (o *Outbox) Entries(states ...string) ([]*OutboxEntry, error) {
+	var res []*OutboxEntry
+	err := o.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bktOutbox).ForEach(func(k, v []byte) error {
+			e := &OutboxEntry{}
+			if err := json.Unmarshal(v, e); err != nil {
+				return err
+			}
+			if matchState(e.State, states) {
+				res = append(res, e)
+			}
+			return nil
+		})
+	})
+	return res, err
+}
+
+// Purge removes the entries in the states `states`, or in any state if none is
+// given, last updated before `before`.  It returns the number of removed
+// entries.  Removing queued entries drops them from the replay.
+func (o *Outbox) Purge(before time.Time, states ...string) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	n := 0
+	err := o.db.Update(func(tx *bolt.Tx) error {
+		b, q := tx.Bucket(bktOutbox), tx.Bucket(bktOutboxQueue)
+		var keys [][]byte
+		err := b.ForEach(func(k, v []byte) error {
+			var e OutboxEntry
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+			if e.Updated.Before(before) && matchState(e.State, states) {
+				keys = append(keys, k)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		// deleting while iterating would skip keys.
+		for _, k := range keys {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+			if err := q.Delete(k); err != nil {
+				return err
+			}
+		}
+		n = len(keys)
+		return nil
+	})
+	return n, err
+}
+
+func matchState(state string, states []string) bool {
+	if len(states) == 0 {
+		return true
+	}
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
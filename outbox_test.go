@@ -0,0 +1,248 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/errors/status"
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedContract returns the errors of `errs` by function name.
+type scriptedContract struct {
+	fakeContract
+	mu    sync.Mutex
+	errs  map[string]error
+	order []string
+}
+
+func (f *scriptedContract) SubmitTransaction(name string, args ...string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.errs[name]; err != nil {
+		return nil, err
+	}
+	if err := f.errs["*"]; err != nil {
+		return nil, err
+	}
+	f.order = append(f.order, name)
+	return []byte("ok-" + name), nil
+}
+
+func (f *scriptedContract) set(name string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.errs[name] = err
+}
+
+func (f *scriptedContract) sent() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.order...)
+}
+
+func testOutbox(t *testing.T) (*Outbox, *scriptedContract) {
+	dir, err := ioutil.TempDir("", "outbox")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	f := &scriptedContract{errs: map[string]error{}}
+	c := fakeClient(&f.fakeContract)
+	c.contract = f
+	o, err := NewOutbox(c, filepath.Join(dir, "outbox.db"), WithReplayInterval(10*time.Millisecond))
+	require.NoError(t, err)
+	t.Cleanup(func() { o.Close() })
+	return o, f
+}
+
+func Test_Outbox(t *testing.T) {
+	require := require.New(t)
+
+	o, f := testOutbox(t)
+	res, err := o.Invoke("a")
+	require.NoError(err)
+	require.Equal("ok-a", string(res))
+
+	// outage: the calls are queued, even after the network is back
+	f.set("*", errors.New("dial tcp: connection refused"))
+	_, err = o.Invoke("b")
+	require.ErrorIs(err, ErrQueued)
+	f.set("*", nil)
+	f.set("c", errors.New("car CAR1 does not exist"))
+	_, err = o.Invoke("c")
+	require.ErrorIs(err, ErrQueued)
+	_, err = o.Invoke("d")
+	require.ErrorIs(err, ErrQueued)
+	n, err := o.Len()
+	require.NoError(err)
+	require.Equal(3, n)
+
+	// a business error is not queued
+	o2, f2 := testOutbox(t)
+	f2.set("x", errors.New("car CAR1 does not exist"))
+	_, err = o2.Invoke("x")
+	require.EqualError(err, "car CAR1 does not exist")
+
+	// replay
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- o.Run(ctx) }()
+	require.Eventually(func() bool {
+		n, _ := o.Len()
+		return n == 0
+	}, time.Second, 5*time.Millisecond)
+	cancel()
+	require.ErrorIs(<-done, context.Canceled)
+	require.Equal([]string{"a", "b", "d"}, f.sent())
+
+	failed, err := o.Entries(OutboxFailed)
+	require.NoError(err)
+	require.Len(failed, 1)
+	require.Equal("c", failed[0].Fn)
+	require.Equal("car CAR1 does not exist", failed[0].LastError)
+	e, err := o.Entry(1)
+	require.NoError(err)
+	require.Equal(OutboxSent, e.State)
+	require.Equal("b", e.Fn)
+	require.Equal("ok-b", string(e.Payload))
+	require.Equal(1, e.Attempts)
+
+	all, err := o.Entries()
+	require.NoError(err)
+	require.Len(all, 3)
+	n, err = o.Purge(time.Now().Add(time.Second), OutboxSent)
+	require.NoError(err)
+	require.Equal(2, n)
+	all, err = o.Entries()
+	require.NoError(err)
+	require.Len(all, 1)
+}
+
+func Test_Outbox_ReplayOutage(t *testing.T) {
+	require := require.New(t)
+
+	o, f := testOutbox(t)
+	f.set("*", errors.New("rpc error: code = Unavailable desc = connection refused"))
+	_, err := o.Invoke("a")
+	require.ErrorIs(err, ErrQueued)
+	_, err = o.Invoke("b")
+	require.ErrorIs(err, ErrQueued)
+
+	idle, err := o.replay(context.Background())
+	require.NoError(err)
+	require.False(idle)
+	e, _ := o.Entry(1)
+	require.Equal(OutboxQueued, e.State)
+	require.Equal(1, e.Attempts)
+	require.Contains(e.LastError, "Unavailable")
+
+	f.set("*", nil)
+	idle, err = o.replay(context.Background())
+	require.NoError(err)
+	require.True(idle)
+	require.Equal([]string{"a", "b"}, f.sent())
+}
+
+func Test_Outbox_idempotencyKey(t *testing.T) {
+	require := require.New(t)
+
+	o, _ := testOutbox(t)
+	o2, _ := testOutbox(t)
+	e := &OutboxEntry{Seq: 1}
+	require.NotEqual(o.idempotencyKey(e), o2.idempotencyKey(e))
+	require.Contains(o.idempotencyKey(e), o.id)
+
+	// the ID is kept by the database
+	file := o.db.Path()
+	key := o.idempotencyKey(e)
+	require.NoError(o.Close())
+	o, err := NewOutbox(o.c, file)
+	require.NoError(err)
+	defer o.Close()
+	require.Equal(key, o.idempotencyKey(e))
+}
+
+func Test_Outbox_ConcurrentInvoke(t *testing.T) {
+	require := require.New(t)
+
+	o, _ := testOutbox(t)
+	var mu sync.Mutex
+	inflight := 0
+	release := make(chan struct{})
+	o.submit = func(ctx context.Context, e *OutboxEntry) ([]byte, error) {
+		mu.Lock()
+		inflight++
+		mu.Unlock()
+		<-release
+		return []byte("ok"), nil
+	}
+	errs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := o.Invoke("a")
+			errs <- err
+		}()
+	}
+	// the submissions do not wait for each other.
+	require.Eventually(func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return inflight == 2
+	}, time.Second, time.Millisecond)
+	close(release)
+	require.NoError(<-errs)
+	require.NoError(<-errs)
+}
+
+func Test_Outbox_CommitTimeout(t *testing.T) {
+	require := require.New(t)
+	timeout := status.New(status.ClientStatus, status.Timeout.ToInt32(), "Execute didn't receive block event", nil)
+
+	// the transaction may have reached the orderer: it is not queued.
+	o, f := testOutbox(t)
+	f.set("a", timeout)
+	_, err := o.Invoke("a")
+	require.NotErrorIs(err, ErrQueued)
+	n, err := o.Len()
+	require.NoError(err)
+	require.Zero(n)
+
+	// with an IdempotencyStore, it is queued before its submission, and
+	// replayed under the same key.
+	o.c.idemStore = NewMemoryIdempotencyStore()
+	var keys []string
+	o.submit = func(ctx context.Context, e *OutboxEntry) ([]byte, error) {
+		keys = append(keys, o.idempotencyKey(e))
+		if len(keys) == 1 {
+			return nil, timeout
+		}
+		return []byte("ok"), nil
+	}
+	_, err = o.Invoke("a")
+	require.ErrorIs(err, ErrQueued)
+	e, err := o.Entry(1)
+	require.NoError(err)
+	require.Equal(OutboxQueued, e.State)
+	require.Equal(1, e.Attempts)
+	idle, err := o.replay(context.Background())
+	require.NoError(err)
+	require.True(idle)
+	require.Equal([]string{o.idempotencyKey(e), o.idempotencyKey(e)}, keys)
+	e, _ = o.Entry(1)
+	require.Equal(OutboxSent, e.State)
+
+	res, err := o.Invoke("b")
+	require.NoError(err)
+	require.Equal("ok", string(res))
+	e, _ = o.Entry(2)
+	require.Equal(OutboxSent, e.State)
+}
@@ -0,0 +1,277 @@
+// v0.2.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"container/list"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/core"
+	"github.com/hyperledger/fabric-sdk-go/pkg/core/config"
+)
+
+// ClientPool caches one Client per wallet identity.  The Clients are created at
+// first use and share the parsed Configuration and connection profile.  When
+// the pool is full, the least recently used idle Client is closed.  Idle Clients
+// are also closed after the idle timeout.  A ClientPool is safe for concurrent
+// use.
+type ClientPool struct {
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List // front is the most recently used
+	closed  bool
+	done    chan struct{}
+
+	maxSize     int
+	idleTimeout time.Duration
+	newClient   func(user string) (*Client, error)
+}
+
+// poolEntry is the Client of one identity.  `ready` is closed once the Client
+// is created.
+type poolEntry struct {
+	user     string
+	client   *Client
+	err      error
+	ready    chan struct{}
+	refs     int
+	lastUsed time.Time
+}
+
+type poolOptions struct {
+	maxSize     int
+	idleTimeout time.Duration
+	clientOpts  []ClientOption
+}
+
+// PoolOption allows to parameterize the NewClientPool function.
+type PoolOption func(opts *poolOptions)
+
+// WithMaxClients limits the pool to `n` Clients.  The default is 16.
+func WithMaxClients(n int) PoolOption {
+	return func(po *poolOptions) {
+		po.maxSize = n
+	}
+}
+
+// WithIdleTimeout closes the Clients unused for `d`.  Zero, the default,
+// keeps idle Clients until they are evicted by newer ones.
+func WithIdleTimeout(d time.Duration) PoolOption {
+	return func(po *poolOptions) {
+		po.idleTimeout = d
+	}
+}
+
+// WithClientOptions applies `options` to every Client of the pool.  WithUser
+// is ignored as the pool selects the user.
+func WithClientOptions(options ...ClientOption) PoolOption {
+	return func(po *poolOptions) {
+		po.clientOpts = append(po.clientOpts, options...)
+	}
+}
+
+// NewClientPool creates a pool of Clients for the configuration defined by file
+// `configFile` in directory `path`.
+func NewClientPool(configFile string, path string, options ...PoolOption) (*ClientPool, error) {
+	po := poolOptions{maxSize: 16}
+	for _, option := range options {
+		option(&po)
+	}
+	cp, err := loadConfiguration(configFile, path)
+	if err != nil {
+		return nil, err
+	}
+	provider := sharedProvider(config.FromFile(filepath.Clean(cp.ConnectionFile)))
+	p := newClientPool(po, func(user string) (*Client, error) {
+		clOpts := clientOptions{}
+		for _, option := range po.clientOpts {
+			option(&clOpts)
+		}
+		clOpts.user = user
+		clOpts.provider = provider
+		cfg := *cp
+		return newClient(&cfg, clOpts)
+	})
+	return p, nil
+}
+
+// newClientPool creates a pool using `create` to create the Clients.
+func newClientPool(po poolOptions, create func(user string) (*Client, error)) *ClientPool {
+	p := &ClientPool{
+		entries:     make(map[string]*list.Element),
+		lru:         list.New(),
+		done:        make(chan struct{}),
+		maxSize:     po.maxSize,
+		idleTimeout: po.idleTimeout,
+		newClient:   create,
+	}
+	if p.idleTimeout > 0 {
+		go p.janitor()
+	}
+	return p
+}
+
+// Get returns the Client of identity `user`, creating it if needed.  The Client
+// is reserved until the matching call to Release.  It returns ErrPoolFull if
+// the pool is full of reserved Clients.
+func (p *ClientPool) Get(user string) (*Client, error) {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, ErrPoolClosed
+	}
+	if el, ok := p.entries[user]; ok {
+		e := el.Value.(*poolEntry)
+		e.refs++
+		p.lru.MoveToFront(el)
+		p.mu.Unlock()
+		<-e.ready
+		if e.err != nil {
+			// the creator removes the failed entry.
+			return nil, e.err
+		}
+		return e.client, nil
+	}
+	if p.lru.Len() >= p.maxSize && !p.evictOne() {
+		p.mu.Unlock()
+		return nil, ErrPoolFull
+	}
+	e := &poolEntry{user: user, ready: make(chan struct{}), refs: 1}
+	el := p.lru.PushFront(e)
+	p.entries[user] = el
+	p.mu.Unlock()
+
+	// the connection is created out of the lock as it is slow.
+	e.client, e.err = p.newClient(user)
+	close(e.ready)
+	if e.err != nil {
+		Logr.Errorf("pool: could not create client of %s: %v", user, e.err)
+		p.mu.Lock()
+		if cur, ok := p.entries[user]; ok && cur == el {
+			delete(p.entries, user)
+			p.lru.Remove(el)
+		}
+		p.mu.Unlock()
+		return nil, e.err
+	}
+	return e.client, nil
+}
+
+// Release frees the reservation of the Client of `user` obtained by Get.
+func (p *ClientPool) Release(user string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if el, ok := p.entries[user]; ok {
+		e := el.Value.(*poolEntry)
+		if e.refs > 0 {
+			e.refs--
+		}
+		e.lastUsed = time.Now()
+	}
+}
+
+// Do calls `fn` with the Client of `user` and releases it afterwards.
+func (p *ClientPool) Do(user string, fn func(c *Client) error) error {
+	c, err := p.Get(user)
+	if err != nil {
+		return err
+	}
+	defer p.Release(user)
+	return fn(c)
+}
+
+// Len returns the number of Clients in the pool.
+func (p *ClientPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.lru.Len()
+}
+
+// Close closes all the Clients of the pool.  Further Get calls return
+// ErrPoolClosed.
+func (p *ClientPool) Close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.done)
+	var clients []*poolEntry
+	for el := p.lru.Front(); el != nil; el = el.Next() {
+		clients = append(clients, el.Value.(*poolEntry))
+	}
+	p.entries = make(map[string]*list.Element)
+	p.lru.Init()
+	p.mu.Unlock()
+
+	for _, e := range clients {
+		<-e.ready
+		if e.client != nil {
+			e.client.Close()
+		}
+	}
+}
+
+// evictOne closes the least recently used idle Client.  It returns false if all
+// the Clients are reserved.  The caller holds the lock.
+func (p *ClientPool) evictOne() bool {
+	for el := p.lru.Back(); el != nil; el = el.Prev() {
+		e := el.Value.(*poolEntry)
+		if e.refs == 0 {
+			p.remove(el)
+			return true
+		}
+	}
+	return false
+}
+
+// remove removes `el` from the pool and closes its Client.  The caller holds the
+// lock.  Only idle entries, thus already created, are removed.
+func (p *ClientPool) remove(el *list.Element) {
+	e := el.Value.(*poolEntry)
+	delete(p.entries, e.user)
+	p.lru.Remove(el)
+	Logr.Debugf("pool: closing client of %s", e.user)
+	go e.client.Close()
+}
+
+// janitor periodically closes the Clients idle for longer than the idle timeout.
+func (p *ClientPool) janitor() {
+	ticker := time.NewTicker(p.idleTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.done:
+			return
+		case now := <-ticker.C:
+			p.mu.Lock()
+			for el := p.lru.Back(); el != nil; {
+				prev := el.Prev()
+				e := el.Value.(*poolEntry)
+				if e.refs == 0 && now.Sub(e.lastUsed) >= p.idleTimeout {
+					p.remove(el)
+				}
+				el = prev
+			}
+			p.mu.Unlock()
+		}
+	}
+}
+
+// sharedProvider returns a provider loading the backends of `provider` once.
+func sharedProvider(provider core.ConfigProvider) core.ConfigProvider {
+	var once sync.Once
+	var backends []core.ConfigBackend
+	var err error
+	return func() ([]core.ConfigBackend, error) {
+		once.Do(func() {
+			backends, err = provider()
+		})
+		return backends, err
+	}
+}
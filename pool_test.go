@@ -0,0 +1,94 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePool returns a pool whose Clients are not connected, and the counter of
+// created Clients.
+func fakePool(po poolOptions) (*ClientPool, *int32) {
+	var created int32
+	return newClientPool(po, func(user string) (*Client, error) {
+		if user == "bad" {
+			return nil, errors.New("unknown identity")
+		}
+		atomic.AddInt32(&created, 1)
+		time.Sleep(5 * time.Millisecond)
+		return &Client{cfg: &Configuration{User: user}}, nil
+	}), &created
+}
+
+func Test_ClientPool_LRU(t *testing.T) {
+	p, created := fakePool(poolOptions{maxSize: 2})
+	defer p.Close()
+
+	c1, err := p.Get("user1")
+	require.NoError(t, err)
+	require.Equal(t, "user1", c1.cfg.User)
+	c1bis, err := p.Get("user1")
+	require.NoError(t, err)
+	require.True(t, c1 == c1bis)
+	require.NoError(t, p.Do("user2", func(c *Client) error { return nil }))
+	require.Equal(t, 2, p.Len())
+
+	// user1 is reserved, so user2 is evicted.
+	require.NoError(t, p.Do("user3", func(c *Client) error { return nil }))
+	require.Equal(t, 2, p.Len())
+	require.EqualValues(t, 3, atomic.LoadInt32(created))
+	// user3 is idle but user1 is reserved twice.
+	p.Release("user1")
+	_, err = p.Get("user4")
+	require.NoError(t, err)
+	_, err = p.Get("user5")
+	require.ErrorIs(t, err, ErrPoolFull)
+
+	_, err = p.Get("bad")
+	require.Error(t, err)
+}
+
+func Test_ClientPool_Idle(t *testing.T) {
+	p, _ := fakePool(poolOptions{maxSize: 4, idleTimeout: 20 * time.Millisecond})
+	require.NoError(t, p.Do("user1", func(c *Client) error { return nil }))
+	_, err := p.Get("user2")
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return p.Len() == 1 }, time.Second, 5*time.Millisecond)
+
+	p.Close()
+	require.Equal(t, 0, p.Len())
+	_, err = p.Get("user1")
+	require.ErrorIs(t, err, ErrPoolClosed)
+}
+
+func Test_ClientPool_Concurrent(t *testing.T) {
+	p, created := fakePool(poolOptions{maxSize: 8})
+	defer p.Close()
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			user := fmt.Sprintf("user%d", i%4)
+			err := p.Do(user, func(c *Client) error {
+				if c.cfg.User != user {
+					return errors.New("wrong client")
+				}
+				return nil
+			})
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+	require.EqualValues(t, 4, atomic.LoadInt32(created))
+}
@@ -0,0 +1,141 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// RateLimit defines a token bucket.  The zero value does not limit the rate.
+type RateLimit struct {
+	// Rate is the number of calls per second.
+	Rate float64
+	// Burst is the number of calls allowed at once.  It defaults to 1.
+	Burst int
+	// MaxQueue is the maximal number of calls waiting for a token.  The calls
+	// beyond it fail with ErrRateLimited.  Zero rejects every call that would
+	// wait.
+	MaxQueue int
+}
+
+// loadRateLimit reads the section [ratelimit.`kind`] of the configuration file.
+func loadRateLimit(vi *viper.Viper, kind string) RateLimit {
+	key := "ratelimit." + kind + "."
+	return RateLimit{
+		Rate:     vi.GetFloat64(key + "rate"),
+		Burst:    vi.GetInt(key + "burst"),
+		MaxQueue: vi.GetInt(key + "queue"),
+	}
+}
+
+// limiter is a token bucket with a bounded queue of waiting calls.  The waiting
+// calls reserve their token, thus they are served in order.  It is safe for
+// concurrent use.
+type limiter struct {
+	rate     float64
+	burst    float64
+	maxQueue int
+	now      func() time.Time
+
+	mu      sync.Mutex
+	tokens  float64 // negative when tokens are reserved by waiting calls.
+	last    time.Time
+	waiting int
+}
+
+// newLimiter returns the limiter of `rl`, or nil if `rl` does not limit.
+func newLimiter(rl RateLimit) *limiter {
+	if rl.Rate <= 0 {
+		return nil
+	}
+	if rl.Burst < 1 {
+		rl.Burst = 1
+	}
+	l := &limiter{rate: rl.Rate, burst: float64(rl.Burst), maxQueue: rl.MaxQueue, now: time.Now}
+	l.tokens, l.last = l.burst, l.now()
+	return l
+}
+
+// reserve takes a token.  It returns the duration to wait before using it, or
+// ErrRateLimited if the queue is full.  A positive duration means that the call
+// is queued and must call done.
+func (l *limiter) reserve() (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0, nil
+	}
+	if l.waiting >= l.maxQueue {
+		return 0, ErrRateLimited
+	}
+	l.tokens--
+	l.waiting++
+	// a queued call waits at least 1 ns, so that wait releases its place.
+	d := time.Duration(math.Ceil(-l.tokens / l.rate * float64(time.Second)))
+	if d < 1 {
+		d = 1
+	}
+	return d, nil
+}
+
+// done releases the place in the queue.  `canceled` returns the token.
+func (l *limiter) done(canceled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.waiting--
+	if canceled {
+		l.tokens++
+	}
+}
+
+// wait blocks until a token is available.  It fails with ErrRateLimited if the
+// queue is full, or with the error of `ctx` if done first.
+func (l *limiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	d, err := l.reserve()
+	if err != nil || d == 0 {
+		return err
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		l.done(false)
+		return nil
+	case <-ctx.Done():
+		l.done(true)
+		return ctx.Err()
+	}
+}
+
+// WithSubmitRateLimit limits the rate of the submitted transactions regardless of
+// the section [ratelimit.submit] of the configuration file.
+func WithSubmitRateLimit(rl RateLimit) ClientOption {
+	return func(cp *clientOptions) {
+		cp.submitLimit = &rl
+	}
+}
+
+// WithEvaluateRateLimit limits the rate of the evaluated transactions regardless
+// of the section [ratelimit.evaluate] of the configuration file.
+func WithEvaluateRateLimit(rl RateLimit) ClientOption {
+	return func(cp *clientOptions) {
+		cp.evaluateLimit = &rl
+	}
+}
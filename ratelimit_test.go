@@ -0,0 +1,121 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_limiter_reserve(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Now()
+	l := newLimiter(RateLimit{Rate: 10, Burst: 2, MaxQueue: 2})
+	l.now = func() time.Time { return now }
+	l.last = now
+
+	for i := 0; i < 2; i++ {
+		d, err := l.reserve()
+		require.NoError(err)
+		require.Zero(d)
+	}
+	d, err := l.reserve()
+	require.NoError(err)
+	require.Equal(100*time.Millisecond, d)
+	d, err = l.reserve()
+	require.NoError(err)
+	require.Equal(200*time.Millisecond, d)
+	_, err = l.reserve()
+	require.ErrorIs(err, ErrRateLimited)
+
+	// a canceled call frees its place and token
+	l.done(true)
+	d, err = l.reserve()
+	require.NoError(err)
+	require.Equal(200*time.Millisecond, d)
+
+	l.done(false)
+	l.done(false)
+	now = now.Add(time.Second)
+	d, err = l.reserve()
+	require.NoError(err)
+	require.Zero(d)
+
+	// a token almost available still queues the call.
+	l = newLimiter(RateLimit{Rate: 1, MaxQueue: 1})
+	l.now = func() time.Time { return now }
+	l.tokens, l.last = 1-1e-12, now
+	d, err = l.reserve()
+	require.NoError(err)
+	require.Equal(time.Duration(1), d)
+	l.done(false)
+	require.Zero(l.waiting)
+
+	require.Nil(newLimiter(RateLimit{}))
+	require.NoError((*limiter)(nil).wait(context.Background()))
+}
+
+func Test_limiter_wait(t *testing.T) {
+	require := require.New(t)
+
+	l := newLimiter(RateLimit{Rate: 100, MaxQueue: 10})
+	start := time.Now()
+	var wg sync.WaitGroup
+	errs := make(chan error, 6)
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- l.wait(context.Background())
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(err)
+	}
+	require.True(time.Since(start) >= 45*time.Millisecond)
+
+	l = newLimiter(RateLimit{Rate: 1, MaxQueue: 1})
+	require.NoError(l.wait(context.Background()))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(l.wait(ctx), context.DeadlineExceeded)
+	require.Zero(l.waiting)
+}
+
+func Test_Client_RateLimit(t *testing.T) {
+	require := require.New(t)
+
+	f := &fakeContract{}
+	c := fakeClient(f)
+	c.submitLim = newLimiter(RateLimit{Rate: 1})
+	_, err := c.Invoke("createCar")
+	require.NoError(err)
+	_, err = c.Invoke("createCar")
+	require.ErrorIs(err, ErrRateLimited)
+	// evaluations have their own limit
+	for i := 0; i < 5; i++ {
+		_, err = c.Query("QueryCar")
+		require.NoError(err)
+	}
+	require.EqualValues(6, f.calls)
+}
+
+func Test_Configuration_Load_RateLimit(t *testing.T) {
+	cp := loadTestConfig(t, `
+[ratelimit.submit]
+rate = 20
+burst = 5
+queue = 100
+`)
+	require.Equal(t, RateLimit{Rate: 20, Burst: 5, MaxQueue: 100}, cp.SubmitLimit)
+	require.Equal(t, RateLimit{}, cp.EvaluateLimit)
+}
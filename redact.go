@@ -0,0 +1,209 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// Masked replaces the redacted values.
+const Masked = "***"
+
+// secretPatterns match the secrets redacted from every log message.
+var secretPatterns = []*regexp.Regexp{
+	// PEM private keys
+	regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`),
+	// key=value or key: value secrets
+	regexp.MustCompile(`(?i)((?:secret|password|passwd|pwd|token|privatekey|private_key)["']?\s*[=:]\s*["']?)[^\s"',}]+`),
+	// signing plaintext and digests of the SDK
+	regexp.MustCompile(`((?:plaintext|digest): )[0-9A-Fa-f.]+`),
+}
+
+// redactor holds the redaction rules of the package logger.  It is safe for
+// concurrent use.
+type redactor struct {
+	mu      sync.RWMutex
+	secrets []string                // literal secrets, longest first
+	masks   map[string]map[int]bool // function -> masked argument positions
+	extra   []*regexp.Regexp
+}
+
+var logRedactor = &redactor{masks: make(map[string]map[int]bool)}
+
+// RedactSecret masks `secret` wherever it appears in the log messages.  The
+// enrollment secret of the configuration is registered automatically.
+func RedactSecret(secret string) {
+	if secret == "" {
+		return
+	}
+	r := logRedactor
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.secrets {
+		if s == secret {
+			return
+		}
+	}
+	r.secrets = append(r.secrets, secret)
+	sort.Slice(r.secrets, func(i, j int) bool { return len(r.secrets[i]) > len(r.secrets[j]) })
+}
+
+// RedactPattern masks the matches of the regular expression `pattern` in the
+// log messages.
+func RedactPattern(pattern string) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+	r := logRedactor
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.extra = append(r.extra, re)
+	return nil
+}
+
+// SetArgumentMask masks the arguments at `positions`, starting at 0, of the
+// transaction `fn` when they are logged.  A negative position masks every
+// argument.  Without positions, the arguments of `fn` are no more masked.  The
+// function names are case insensitive as viper lower cases the TOML keys.
+func SetArgumentMask(fn string, positions ...int) {
+	r := logRedactor
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	fn = strings.ToLower(fn)
+	if len(positions) == 0 {
+		delete(r.masks, fn)
+		return
+	}
+	m := make(map[int]bool, len(positions))
+	for _, p := range positions {
+		m[p] = true
+	}
+	r.masks[fn] = m
+}
+
+// RedactArgs returns a copy of `args` of transaction `fn` where the masked
+// arguments are replaced by Masked and the secrets are redacted.
+func RedactArgs(fn string, args []string) []string {
+	r := logRedactor
+	r.mu.RLock()
+	m := r.masks[strings.ToLower(fn)]
+	r.mu.RUnlock()
+	res := make([]string, len(args))
+	for i, a := range args {
+		if m[i] || m[-1] {
+			res[i] = Masked
+		} else {
+			res[i] = Redact(a)
+		}
+	}
+	return res
+}
+
+// Redact returns `s` without the registered secrets, the private keys and the
+// values of secret fields.
+func Redact(s string) string {
+	r := logRedactor
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, secret := range r.secrets {
+		s = strings.ReplaceAll(s, secret, Masked)
+	}
+	for _, re := range secretPatterns {
+		s = re.ReplaceAllString(s, "${1}"+Masked)
+	}
+	for _, re := range r.extra {
+		s = re.ReplaceAllString(s, Masked)
+	}
+	return s
+}
+
+// loadRedaction reads the section [redact] of the configuration file, e.g.,
+//
+//	[redact]
+//	patterns = ["SSN-[0-9]+"]
+//	[redact.args]
+//	createUser = [1, 2]
+func loadRedaction(vi *viper.Viper) error {
+	for _, p := range vi.GetStringSlice("redact.patterns") {
+		if err := RedactPattern(p); err != nil {
+			Logr.Errorf("invalid redaction pattern %q: %v", p, err)
+			return err
+		}
+	}
+	args, _ := vi.Get("redact.args").(map[string]interface{})
+	for fn, v := range args {
+		var pos []int
+		switch p := v.(type) {
+		case []interface{}:
+			for _, x := range p {
+				n, ok := x.(int64)
+				if !ok {
+					return fmt.Errorf("invalid position %v of redact.args.%s", x, fn)
+				}
+				pos = append(pos, int(n))
+			}
+		case int64:
+			pos = []int{int(p)}
+		default:
+			return fmt.Errorf("invalid redact.args.%s", fn)
+		}
+		SetArgumentMask(fn, pos...)
+	}
+	return nil
+}
+
+// redactHook redacts the messages and fields of the log entries.
+type redactHook struct{}
+
+func (redactHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (redactHook) Fire(e *logrus.Entry) error {
+	e.Message = Redact(e.Message)
+	for k, v := range e.Data {
+		switch x := v.(type) {
+		case string:
+			e.Data[k] = Redact(x)
+		case error:
+			e.Data[k] = Redact(x.Error())
+		case []string:
+			res := make([]string, len(x))
+			for i := range x {
+				res[i] = Redact(x[i])
+			}
+			e.Data[k] = res
+		}
+	}
+	return nil
+}
+
+// configuration has the fields of Configuration without its methods.
+type configuration Configuration
+
+// String prints the Configuration with its secret masked.
+func (c Configuration) String() string {
+	return fmt.Sprintf("%+v", c.masked())
+}
+
+// GoString prints the Configuration with its secret masked.
+func (c Configuration) GoString() string {
+	return fmt.Sprintf("%#v", c.masked())
+}
+
+func (c Configuration) masked() configuration {
+	if c.UserPwd != "" {
+		c.UserPwd = Masked
+	}
+	return configuration(c)
+}
@@ -0,0 +1,103 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func testKey(t *testing.T) string {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "conf", "wallet", "user1.id"))
+	require.NoError(t, err)
+	var id struct {
+		Credentials struct {
+			PrivateKey string `json:"privateKey"`
+		} `json:"credentials"`
+	}
+	require.NoError(t, json.Unmarshal(data, &id))
+	return strings.TrimSpace(id.Credentials.PrivateKey)
+}
+
+func Test_Redact(t *testing.T) {
+	require := require.New(t)
+
+	key := testKey(t)
+	require.NotEmpty(key)
+	require.Equal("key "+Masked+" end", Redact("key "+key+" end"))
+	require.Equal("enroll with secret="+Masked+" done", Redact("enroll with secret=s3cr3t done"))
+	require.Equal(`{"password": "`+Masked+`"}`, Redact(`{"password": "hunter2"}`))
+	require.Equal("Sign: plaintext: "+Masked+" ", Redact("Sign: plaintext: 18012A500A3F...7E120D08C3 "))
+
+	RedactSecret("adminpw")
+	require.Equal("user1 enrolled by "+Masked, Redact("user1 enrolled by adminpw"))
+	require.Equal("nothing to hide", Redact("nothing to hide"))
+}
+
+func Test_RedactArgs(t *testing.T) {
+	require := require.New(t)
+
+	SetArgumentMask("createUser", 1, 2)
+	defer SetArgumentMask("createUser")
+	require.Equal([]string{"bob", Masked, Masked, "x"}, RedactArgs("createUser", []string{"bob", "card", "ssn", "x"}))
+	require.Equal([]string{"CAR1", "password:" + Masked}, RedactArgs("createCar", []string{"CAR1", "password:abc"}))
+
+	SetArgumentMask("Record", -1)
+	defer SetArgumentMask("Record")
+	require.Equal([]string{Masked, Masked}, RedactArgs("Record", []string{"a", "b"}))
+}
+
+func Test_redactHook(t *testing.T) {
+	require := require.New(t)
+
+	RedactSecret("topsecret42")
+	var buf bytes.Buffer
+	l := logrus.New()
+	l.Out = &buf
+	l.AddHook(redactHook{})
+	l.WithFields(logrus.Fields{"pwd": "topsecret42", "err": errors.New("bad topsecret42")}).
+		Infof("enrolling with %s", "topsecret42")
+	require.NotContains(buf.String(), "topsecret42")
+	require.Contains(buf.String(), Masked)
+}
+
+func Test_Configuration_String(t *testing.T) {
+	require := require.New(t)
+
+	cp := &Configuration{User: "user1", UserPwd: "user1pw"}
+	for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+		for _, v := range []interface{}{cp, *cp} {
+			out := fmt.Sprintf(format, v)
+			require.NotContains(out, "user1pw", format)
+			require.Contains(out, "user1", format)
+		}
+	}
+	require.Equal("user1pw", cp.UserPwd)
+}
+
+func Test_Configuration_Load_Redact(t *testing.T) {
+	require := require.New(t)
+
+	cp := loadTestConfig(t, `UserPwd = "enrollpw99"
+[redact]
+patterns = ["SSN-[0-9]+"]
+[redact.args]
+setOwner = [1]
+`)
+	defer SetArgumentMask("setOwner")
+	require.Equal("pw "+Masked, Redact("pw enrollpw99"))
+	require.Equal("id "+Masked, Redact("id SSN-1234"))
+	require.Equal([]string{"CAR1", Masked}, RedactArgs("setOwner", []string{"CAR1", "Bob"}))
+	require.NotContains(cp.String(), "enrollpw99")
+}
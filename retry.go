@@ -0,0 +1,267 @@
+// v0.3.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/errors/status"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+)
+
+// RetryPolicy defines how the Client retries the transactions that fail with
+// a transient error.  The zero value disables the retries.
+type RetryPolicy struct {
+	// MaxAttempts is the maximal number of attempts, including the first one.
+	MaxAttempts int
+	// InitialBackoff is the wait before the second attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between two attempts.
+	MaxBackoff time.Duration
+	// Multiplier is the growth factor of the wait between two attempts.
+	Multiplier float64
+	// Jitter is the fraction of random variation of the wait, within [0, 1].
+	Jitter float64
+	// Retryable classifies the errors.  nil means IsTransient for the
+	// evaluations and IsSafeToResubmit for the submissions.  A custom Retryable
+	// applies to both, thus may commit a transaction twice; see InvokeIdempotent.
+	Retryable func(err error) bool
+}
+
+// DefaultRetryPolicy returns a policy of 3 attempts with an exponential backoff
+// starting at 250 ms.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 250 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// loadRetryPolicy reads the section [retry] of the configuration file.  It
+// returns the zero policy if the section is absent.
+func loadRetryPolicy(vi *viper.Viper) RetryPolicy {
+	if !vi.IsSet("retry.maxAttempts") {
+		return RetryPolicy{}
+	}
+	p := DefaultRetryPolicy()
+	p.MaxAttempts = vi.GetInt("retry.maxAttempts")
+	if vi.IsSet("retry.initialBackoff") {
+		p.InitialBackoff = vi.GetDuration("retry.initialBackoff")
+	}
+	if vi.IsSet("retry.maxBackoff") {
+		p.MaxBackoff = vi.GetDuration("retry.maxBackoff")
+	}
+	if vi.IsSet("retry.multiplier") {
+		p.Multiplier = vi.GetFloat64("retry.multiplier")
+	}
+	if vi.IsSet("retry.jitter") {
+		p.Jitter = vi.GetFloat64("retry.jitter")
+	}
+	return p
+}
+
+// backoff returns the wait after the failed attempt number `attempt`, starting
+// at 1.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	mult := p.Multiplier
+	if mult < 1 {
+		mult = 1
+	}
+	d := float64(p.InitialBackoff) * math.Pow(mult, float64(attempt-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		d *= 1 + p.Jitter*(2*rand.Float64()-1)
+	}
+	return time.Duration(d)
+}
+
+func (p RetryPolicy) retryable(err error, submit bool) bool {
+	switch {
+	case p.Retryable != nil:
+		return p.Retryable(err)
+	case submit:
+		return IsSafeToResubmit(err)
+	}
+	return IsTransient(err)
+}
+
+// RetryError occurs when a transaction failed after several attempts.
+// `Attempts` holds the error of each attempt.
+type RetryError struct {
+	Fn       string
+	Attempts []error
+}
+
+func (e *RetryError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s failed after %d attempts", e.Fn, len(e.Attempts))
+	for i, err := range e.Attempts {
+		fmt.Fprintf(&b, "; #%d: %v", i+1, err)
+	}
+	return b.String()
+}
+
+// Unwrap returns the error of the last attempt.
+func (e *RetryError) Unwrap() error {
+	return e.Attempts[len(e.Attempts)-1]
+}
+
+// conflictMarkers and outageMarkers are the fragments of error messages of
+// transient failures.  Some errors reach the Client only as text.
+var conflictMarkers = []string{
+	"MVCC_READ_CONFLICT",
+	"PHANTOM_READ_CONFLICT",
+	"PROPOSALRESPONSEPAYLOADS DO NOT MATCH",
+}
+
+var outageMarkers = []string{
+	"SERVICE_UNAVAILABLE",
+	"CODE = UNAVAILABLE",
+	"CONNECTION REFUSED",
+	"DEADLINE EXCEEDED",
+	"TIMEOUT",
+}
+
+// IsTransient returns true if `err` is worth retrying: MVCC read conflicts,
+// phantom reads, endorsement mismatches, timeouts and unavailable peers or
+// orderers.
+func IsTransient(err error) bool {
+	if err == nil || errors.Is(err, ErrClientClosed) || errors.Is(err, ErrClientNotInitialized) ||
+		errors.Is(err, ErrCircuitOpen) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var ne net.Error
+	if errors.As(err, &ne) && ne.Timeout() {
+		return true
+	}
+	if s, ok := status.FromError(err); ok {
+		switch s.Group {
+		case status.EventServerStatus:
+			code := peer.TxValidationCode(s.Code)
+			return code == peer.TxValidationCode_MVCC_READ_CONFLICT ||
+				code == peer.TxValidationCode_PHANTOM_READ_CONFLICT
+		case status.GRPCTransportStatus:
+			switch codes.Code(s.Code) {
+			case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+				return true
+			}
+			return false
+		case status.OrdererServerStatus:
+			return common.Status(s.Code) == common.Status_SERVICE_UNAVAILABLE
+		case status.EndorserClientStatus, status.OrdererClientStatus, status.ClientStatus:
+			switch status.Code(s.Code) {
+			case status.EndorsementMismatch, status.Timeout, status.ConnectionFailed,
+				status.GenericTransient, status.NoPeersFound:
+				return true
+			}
+		}
+	}
+	return containsAny(err, conflictMarkers) || containsAny(err, outageMarkers)
+}
+
+// IsSafeToResubmit returns true if `err` is transient and guarantees that the
+// submitted transaction has no effect on the ledger: it failed before reaching
+// the orderer, or it was committed invalid by a read conflict.  A timeout or an
+// unavailable node may occur once the orderer received the transaction, thus
+// the submission may still commit and is not retried.
+func IsSafeToResubmit(err error) bool {
+	if !IsTransient(err) {
+		return false
+	}
+	if s, ok := status.FromError(err); ok {
+		switch s.Group {
+		case status.EventServerStatus, status.EndorserClientStatus:
+			return true
+		case status.OrdererServerStatus:
+			// the orderer rejected the transaction.
+			return true
+		case status.OrdererClientStatus:
+			return status.Code(s.Code) == status.ConnectionFailed
+		case status.ClientStatus:
+			return status.Code(s.Code) == status.NoPeersFound
+		}
+		return false
+	}
+	return containsAny(err, conflictMarkers) || containsAny(err, refusedMarkers)
+}
+
+// refusedMarkers are the fragments of error messages of connections that were
+// never established, thus that sent nothing.
+var refusedMarkers = []string{"CONNECTION REFUSED"}
+
+// containsAny returns true if the message of `err` contains one of the upper
+// case `markers`.
+func containsAny(err error, markers []string) bool {
+	msg := strings.ToUpper(err.Error())
+	for _, m := range markers {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// withRetry calls `call` for transaction `fn` according to the retry policy of
+// the Client.  `submit` restricts the retries to the errors of IsSafeToResubmit.
+// If the transaction was attempted more than once, the error is a *RetryError.
+// The retries stop when `ctx` is done.
+func (c *Client) withRetry(ctx context.Context, fn string, submit bool, call func() ([]byte, error)) ([]byte, error) {
+	p := c.retry
+	if p.MaxAttempts <= 1 {
+		return call()
+	}
+	var causes []error
+	for attempt := 1; ; attempt++ {
+		res, err := call()
+		if err == nil {
+			return res, nil
+		}
+		causes = append(causes, err)
+		if attempt >= p.MaxAttempts || !p.retryable(err, submit) {
+			if len(causes) == 1 {
+				return nil, err
+			}
+			Logr.WithFields(logrus.Fields{"fn": fn, "attempts": attempt}).Errorf("giving up: %v", err)
+			return nil, &RetryError{Fn: fn, Attempts: causes}
+		}
+		d := p.backoff(attempt)
+		c.metrics.retry(fn)
+		Logr.WithFields(logrus.Fields{"fn": fn, "attempt": attempt}).Warnf("transient failure, retrying in %v: %v", d, err)
+		t := time.NewTimer(d)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return nil, &RetryError{Fn: fn, Attempts: append(causes, ctx.Err())}
+		}
+	}
+}
+
+// WithRetry sets the retry policy of the Client regardless of the section
+// [retry] of the configuration file.
+func WithRetry(policy RetryPolicy) ClientOption {
+	return func(cp *clientOptions) {
+		cp.retry = &policy
+	}
+}
@@ -0,0 +1,145 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/errors/status"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+// flakyContract fails its first `failures` calls with `err`.
+type flakyContract struct {
+	fakeContract
+	failures int
+}
+
+func (f *flakyContract) SubmitTransaction(name string, args ...string) ([]byte, error) {
+	f.calls++
+	if int(f.calls) <= f.failures {
+		return nil, f.err
+	}
+	return []byte(name), nil
+}
+
+func (f *flakyContract) EvaluateTransaction(name string, args ...string) ([]byte, error) {
+	return f.SubmitTransaction(name, args...)
+}
+
+func Test_IsTransient(t *testing.T) {
+	require := require.New(t)
+
+	mvcc := status.New(status.EventServerStatus, int32(peer.TxValidationCode_MVCC_READ_CONFLICT), "received invalid transaction", nil)
+	require.True(IsTransient(mvcc))
+	require.True(IsTransient(fmt.Errorf("submit failed: %w", mvcc)))
+	require.True(IsTransient(status.New(status.EventServerStatus, int32(peer.TxValidationCode_PHANTOM_READ_CONFLICT), "", nil)))
+	require.False(IsTransient(status.New(status.EventServerStatus, int32(peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE), "", nil)))
+	require.True(IsTransient(status.New(status.EndorserClientStatus, status.EndorsementMismatch.ToInt32(), "", nil)))
+	require.True(IsTransient(status.New(status.ClientStatus, status.Timeout.ToInt32(), "", nil)))
+	require.True(IsTransient(status.New(status.GRPCTransportStatus, int32(codes.Unavailable), "", nil)))
+	require.False(IsTransient(status.New(status.GRPCTransportStatus, int32(codes.PermissionDenied), "", nil)))
+	require.True(IsTransient(errors.New("Failed to submit: transaction 1234 failed with status code MVCC_READ_CONFLICT")))
+	require.False(IsTransient(errors.New("car CAR99 does not exist")))
+	require.False(IsTransient(ErrClientClosed))
+	require.False(IsTransient(nil))
+}
+
+func Test_IsSafeToResubmit(t *testing.T) {
+	require := require.New(t)
+
+	require.True(IsSafeToResubmit(status.New(status.EventServerStatus, int32(peer.TxValidationCode_MVCC_READ_CONFLICT), "", nil)))
+	require.True(IsSafeToResubmit(status.New(status.EndorserClientStatus, status.EndorsementMismatch.ToInt32(), "", nil)))
+	require.True(IsSafeToResubmit(status.New(status.OrdererServerStatus, int32(common.Status_SERVICE_UNAVAILABLE), "", nil)))
+	require.True(IsSafeToResubmit(status.New(status.OrdererClientStatus, status.ConnectionFailed.ToInt32(), "", nil)))
+	require.True(IsSafeToResubmit(errors.New("Failed to submit: transaction 1234 failed with status code MVCC_READ_CONFLICT")))
+	require.True(IsSafeToResubmit(errors.New("dial tcp 127.0.0.1:7050: connect: connection refused")))
+	// the orderer may have received the transaction
+	require.False(IsSafeToResubmit(status.New(status.OrdererClientStatus, status.Timeout.ToInt32(), "", nil)))
+	require.False(IsSafeToResubmit(status.New(status.ClientStatus, status.Timeout.ToInt32(), "", nil)))
+	require.False(IsSafeToResubmit(status.New(status.GRPCTransportStatus, int32(codes.Unavailable), "", nil)))
+	require.False(IsSafeToResubmit(errors.New("rpc error: code = Unavailable")))
+	require.False(IsSafeToResubmit(context.DeadlineExceeded))
+	require.False(IsSafeToResubmit(errors.New("car CAR99 does not exist")))
+}
+
+func Test_RetryPolicy_backoff(t *testing.T) {
+	require := require.New(t)
+
+	p := RetryPolicy{MaxAttempts: 5, InitialBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond, Multiplier: 2}
+	require.Equal(100*time.Millisecond, p.backoff(1))
+	require.Equal(200*time.Millisecond, p.backoff(2))
+	require.Equal(300*time.Millisecond, p.backoff(3))
+	p.Jitter = 0.5
+	for i := 0; i < 20; i++ {
+		d := p.backoff(1)
+		require.True(d >= 50*time.Millisecond && d <= 150*time.Millisecond, d)
+	}
+}
+
+func Test_Client_Retry(t *testing.T) {
+	require := require.New(t)
+
+	mvcc := errors.New("transaction failed with status code MVCC_READ_CONFLICT")
+	f := &flakyContract{fakeContract: fakeContract{err: mvcc}, failures: 2}
+	c := fakeClient(&f.fakeContract)
+	c.contract = f
+	c.retry = RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, Multiplier: 2}
+	res, err := c.Invoke("ChangeCarOwner", "CAR1", "Bob")
+	require.NoError(err)
+	require.Equal("ChangeCarOwner", string(res))
+	require.EqualValues(3, f.calls)
+
+	// exhausted
+	f.calls, f.failures = 0, 5
+	_, err = c.Query("QueryCar", "CAR1")
+	var re *RetryError
+	require.True(errors.As(err, &re))
+	require.Len(re.Attempts, 3)
+	require.Equal("QueryCar", re.Fn)
+	require.True(errors.Is(err, mvcc))
+
+	// the outcome of the submission is unknown
+	f.calls, f.failures, f.err = 0, 1, errors.New("rpc error: code = Unavailable")
+	_, err = c.Invoke("ChangeCarOwner", "CAR1", "Bob")
+	require.Equal(f.err, err)
+	require.EqualValues(1, f.calls)
+	f.calls = 0
+	res, err = c.Query("QueryCar", "CAR1")
+	require.NoError(err)
+	require.Equal("QueryCar", string(res))
+	require.EqualValues(2, f.calls)
+
+	// not transient
+	f.calls, f.failures, f.err = 0, 5, errors.New("car CAR1 does not exist")
+	_, err = c.Query("QueryCar", "CAR1")
+	require.Equal(f.err, err)
+	require.EqualValues(1, f.calls)
+}
+
+func Test_Configuration_Load_Retry(t *testing.T) {
+	require := require.New(t)
+
+	cp := loadTestConfig(t, "")
+	require.Equal(0, cp.Retry.MaxAttempts)
+
+	cp = loadTestConfig(t, `
+[retry]
+maxAttempts = 4
+initialBackoff = "100ms"
+jitter = 0
+`)
+	require.Equal(4, cp.Retry.MaxAttempts)
+	require.Equal(100*time.Millisecond, cp.Retry.InitialBackoff)
+	require.Equal(DefaultRetryPolicy().MaxBackoff, cp.Retry.MaxBackoff)
+	require.Equal(0.0, cp.Retry.Jitter)
+}
@@ -0,0 +1,212 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/logging"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/core"
+	"github.com/hyperledger/fabric-sdk-go/pkg/core/logging/api"
+	"github.com/hyperledger/fabric-sdk-go/pkg/core/logging/metadata"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// sdkLoggerProvider routes the logs of the fabric-sdk-go modules into Logr.
+// The SDK installs its logger provider only once per process; init installs
+// this one before any SDK is created.
+type sdkLoggerProvider struct{}
+
+func (sdkLoggerProvider) GetLogger(module string) api.Logger {
+	return &sdkLogger{module: module}
+}
+
+// sdkLogger is the logger of the SDK module `module`.  A message is logged
+// only if it is enabled both by the level of the module and by Logr.Level.
+type sdkLogger struct {
+	module string
+}
+
+func (l *sdkLogger) entry() *logrus.Entry {
+	return Logr.WithField("module", l.module)
+}
+
+func (l *sdkLogger) enabled(level api.Level) bool {
+	return logging.IsEnabledFor(l.module, logging.Level(level))
+}
+
+func (l *sdkLogger) Fatal(args ...interface{}) { l.entry().Fatal(args...) }
+func (l *sdkLogger) Fatalf(format string, args ...interface{}) {
+	l.entry().Fatalf(format, args...)
+}
+func (l *sdkLogger) Fatalln(args ...interface{}) { l.entry().Fatalln(args...) }
+
+func (l *sdkLogger) Panic(args ...interface{}) { l.entry().Panic(args...) }
+func (l *sdkLogger) Panicf(format string, args ...interface{}) {
+	l.entry().Panicf(format, args...)
+}
+func (l *sdkLogger) Panicln(args ...interface{}) { l.entry().Panicln(args...) }
+
+func (l *sdkLogger) Print(args ...interface{}) { l.entry().Info(args...) }
+func (l *sdkLogger) Printf(format string, args ...interface{}) {
+	l.entry().Infof(format, args...)
+}
+func (l *sdkLogger) Println(args ...interface{}) { l.entry().Infoln(args...) }
+
+func (l *sdkLogger) Debug(args ...interface{}) {
+	if l.enabled(api.DEBUG) {
+		l.entry().Debug(args...)
+	}
+}
+func (l *sdkLogger) Debugf(format string, args ...interface{}) {
+	if l.enabled(api.DEBUG) {
+		l.entry().Debugf(format, args...)
+	}
+}
+func (l *sdkLogger) Debugln(args ...interface{}) {
+	if l.enabled(api.DEBUG) {
+		l.entry().Debugln(args...)
+	}
+}
+
+func (l *sdkLogger) Info(args ...interface{}) {
+	if l.enabled(api.INFO) {
+		l.entry().Info(args...)
+	}
+}
+func (l *sdkLogger) Infof(format string, args ...interface{}) {
+	if l.enabled(api.INFO) {
+		l.entry().Infof(format, args...)
+	}
+}
+func (l *sdkLogger) Infoln(args ...interface{}) {
+	if l.enabled(api.INFO) {
+		l.entry().Infoln(args...)
+	}
+}
+
+func (l *sdkLogger) Warn(args ...interface{}) {
+	if l.enabled(api.WARNING) {
+		l.entry().Warn(args...)
+	}
+}
+func (l *sdkLogger) Warnf(format string, args ...interface{}) {
+	if l.enabled(api.WARNING) {
+		l.entry().Warnf(format, args...)
+	}
+}
+func (l *sdkLogger) Warnln(args ...interface{}) {
+	if l.enabled(api.WARNING) {
+		l.entry().Warnln(args...)
+	}
+}
+
+func (l *sdkLogger) Error(args ...interface{}) {
+	if l.enabled(api.ERROR) {
+		l.entry().Error(args...)
+	}
+}
+func (l *sdkLogger) Errorf(format string, args ...interface{}) {
+	if l.enabled(api.ERROR) {
+		l.entry().Errorf(format, args...)
+	}
+}
+func (l *sdkLogger) Errorln(args ...interface{}) {
+	if l.enabled(api.ERROR) {
+		l.entry().Errorln(args...)
+	}
+}
+
+// sdkModules are the modules whose level is set by the connection profile.
+var sdkModules = []string{"fabsdk", "fabsdk/client", "fabsdk/core", "fabsdk/fab",
+	"fabsdk/common", "fabsdk/msp", "fabsdk/util", "fabsdk/context"}
+
+// sdkLevels holds the levels set by SetSDKLogLevel.  Loading a connection
+// profile resets the levels of the "fabsdk" modules to its
+// client.logging.level, so they are applied again afterwards.
+var sdkLevels = struct {
+	sync.Mutex
+	levels map[string]logging.Level
+}{levels: make(map[string]logging.Level)}
+
+// SetSDKLogLevel sets the log level of the fabric-sdk-go module `module`,
+// for instance "fabsdk/fab".  An empty `module` sets the default level of all
+// the modules.  `level` is one of "critical", "error", "warning", "info" or
+// "debug".  It takes precedence over the client.logging.level of the
+// connection profile.
+func SetSDKLogLevel(module string, level string) error {
+	lvl, err := logging.LogLevel(level)
+	if err != nil {
+		return fmt.Errorf("invalid SDK log level %q: %w", level, err)
+	}
+	sdkLevels.Lock()
+	defer sdkLevels.Unlock()
+	sdkLevels.levels[module] = lvl
+	applySDKLevels()
+	return nil
+}
+
+// applySDKLevels applies the levels of sdkLevels, the default one first.
+// sdkLevels must be locked.
+func applySDKLevels() {
+	if lvl, ok := sdkLevels.levels[""]; ok {
+		logging.SetLevel("", lvl)
+		for _, m := range sdkModules {
+			logging.SetLevel(m, lvl)
+		}
+	}
+	for m, lvl := range sdkLevels.levels {
+		if m != "" {
+			logging.SetLevel(m, lvl)
+		}
+	}
+}
+
+// withSDKLogLevels returns a provider that applies again the levels set by
+// SetSDKLogLevel once `provider` loaded the connection profile.
+func withSDKLogLevels(provider core.ConfigProvider) core.ConfigProvider {
+	return func() ([]core.ConfigBackend, error) {
+		backends, err := provider()
+		sdkLevels.Lock()
+		defer sdkLevels.Unlock()
+		applySDKLevels()
+		return backends, err
+	}
+}
+
+// SDKLogLevel returns the log level of the fabric-sdk-go module `module`.
+func SDKLogLevel(module string) string {
+	return strings.ToLower(metadata.ParseString(api.Level(logging.GetLevel(module))))
+}
+
+// loadSDKLog sets the levels of the SDK modules from the section [sdklog].
+//
+//	[sdklog]
+//	level = "error"          # default level of all the modules
+//	[sdklog.modules]
+//	"fabsdk/fab" = "warning"
+func loadSDKLog(vi *viper.Viper) error {
+	if vi.IsSet("sdklog.level") {
+		if err := SetSDKLogLevel("", vi.GetString("sdklog.level")); err != nil {
+			Logr.Errorf("sdklog.level: %v", err)
+			return err
+		}
+	}
+	modules, _ := vi.Get("sdklog.modules").(map[string]interface{})
+	for m, v := range modules {
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("invalid sdklog.modules.%s", m)
+		}
+		if err := SetSDKLogLevel(m, s); err != nil {
+			Logr.Errorf("sdklog.modules.%s: %v", m, err)
+			return err
+		}
+	}
+	return nil
+}
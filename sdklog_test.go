@@ -0,0 +1,82 @@
+// v0.1.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/logging"
+	"github.com/hyperledger/fabric-sdk-go/pkg/core/config"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sdkLogger(t *testing.T) {
+	require := require.New(t)
+
+	var buf bytes.Buffer
+	out, lvl := Logr.Out, Logr.Level
+	Logr.Out, Logr.Level = &buf, logrus.DebugLevel
+	defer func() { Logr.Out, Logr.Level = out, lvl }()
+	defer resetSDKLevels("test/sdklog")()
+
+	require.NoError(SetSDKLogLevel("test/sdklog", "warning"))
+	require.Equal("warning", SDKLogLevel("test/sdklog"))
+	l := logging.NewLogger("test/sdklog")
+	l.Infof("hidden %d", 1)
+	l.Debug("hidden")
+	require.Empty(buf.String())
+	l.Warnf("shown %d", 2)
+	require.Contains(buf.String(), "shown 2")
+	require.Contains(buf.String(), "module=test/sdklog")
+
+	RedactSecret("sdkpw77")
+	l.Errorf("enroll with sdkpw77")
+	require.NotContains(buf.String(), "sdkpw77")
+
+	require.Error(SetSDKLogLevel("test/sdklog", "loud"))
+}
+
+func Test_Configuration_Load_SDKLog(t *testing.T) {
+	require := require.New(t)
+
+	defer resetSDKLevels(append([]string{""}, sdkModules...)...)()
+	loadTestConfig(t, `
+[sdklog]
+level = "error"
+[sdklog.modules]
+"fabsdk/fab" = "debug"
+`)
+	require.Equal("error", SDKLogLevel("fabsdk/client"))
+	require.Equal("debug", SDKLogLevel("fabsdk/fab"))
+
+	// the connection profile sets its client.logging.level
+	_, err := config.FromFile(filepath.Join("testdata", "conf", "config.yaml"))()
+	require.NoError(err)
+	require.Equal("info", SDKLogLevel("fabsdk/fab"))
+	_, err = withSDKLogLevels(config.FromFile(filepath.Join("testdata", "conf", "config.yaml")))()
+	require.NoError(err)
+	require.Equal("error", SDKLogLevel("fabsdk/client"))
+	require.Equal("debug", SDKLogLevel("fabsdk/fab"))
+}
+
+// resetSDKLevels returns a function restoring the levels of `modules` and
+// forgetting the levels set by SetSDKLogLevel.
+func resetSDKLevels(modules ...string) func() {
+	saved := make(map[string]logging.Level)
+	for _, m := range modules {
+		saved[m] = logging.GetLevel(m)
+	}
+	return func() {
+		sdkLevels.Lock()
+		defer sdkLevels.Unlock()
+		for m, lvl := range saved {
+			delete(sdkLevels.levels, m)
+			logging.SetLevel(m, lvl)
+		}
+	}
+}
@@ -0,0 +1,637 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/errors/status"
+)
+
+// DefaultRequestTimeout bounds the duration of a call of the Server, the wait
+// for the commit included.
+const DefaultRequestTimeout = time.Minute
+
+const (
+	// apiPrefix is the path prefix of the routes of the Server.
+	apiPrefix = "/v1"
+	// apiKeyHeader is the header carrying the API key of the caller.
+	apiKeyHeader = "X-API-Key"
+	// maxRequestBody is the maximal size of the body of a request.
+	maxRequestBody = 1 << 20
+	// sseHeartbeat is the period of the comments keeping an event stream alive.
+	sseHeartbeat = 15 * time.Second
+	// metadataTTL is the duration the Server caches the metadata of a chaincode.
+	metadataTTL = 5 * time.Minute
+)
+
+// Server exposes the ledger over HTTP with JSON for the services not written in
+// Go.  Each caller is mapped to a wallet identity, by API key or by TLS client
+// certificate, whose Client is taken from a ClientPool.  The routes are:
+//
+//	POST /v1/evaluate/{function}   evaluates the function
+//	POST /v1/submit/{function}     submits the function and waits for its commit,
+//	                               or only for its ordering with ?async=true
+//	GET  /v1/transactions/{txId}   returns the status of a transaction
+//	GET  /v1/events                streams the chaincode events (server-sent events)
+//	GET  /v1/openapi.json          describes the routes of the chaincode
+//
+// The body of evaluate and submit is {"args": [...]}.  String arguments are
+// passed unchanged, the others in JSON.  The optional query parameters channel,
+// chaincode and contract select another chaincode than the one of the
+// configuration.  If the chaincode exposes its metadata, the function and its
+// arguments are checked before reaching the peers.  The results are returned as
+// JSON if they are JSON, else as strings.
+type Server struct {
+	pool    *ClientPool
+	keys    map[string]string // hex SHA-256 of the API key -> user
+	certs   map[string]string // certificate common name -> user
+	timeout time.Duration
+
+	mdMu sync.Mutex
+	mds  map[string]mdEntry // channel/chaincode -> metadata
+
+	// the ledger accesses, replaced by the tests.
+	submitAsync func(ct *Contract, ctx context.Context, fn string, args ...string) (*SubmitHandle, error)
+	txStatus    func(c *Client, txID string) (*TxStatus, error)
+	events      func(ct *Contract, ctx context.Context, filter string, opts ...EventOption) (<-chan *ChaincodeEvent, error)
+}
+
+// mdEntry is a cached metadata.  `md` is nil if the chaincode has none.
+type mdEntry struct {
+	md      *Metadata
+	expires time.Time
+}
+
+type serverOptions struct {
+	keys    map[string]string
+	certs   map[string]string
+	timeout time.Duration
+}
+
+// ServerOption allows to parameterize the NewServer function.
+type ServerOption func(opts *serverOptions)
+
+// WithAPIKeys maps the API keys to the wallet identities.  The caller sends its
+// key in the header X-API-Key or as a bearer token.
+func WithAPIKeys(keys map[string]string) ServerOption {
+	return func(so *serverOptions) {
+		for k, user := range keys {
+			so.keys[hashKey(k)] = user
+		}
+	}
+}
+
+// WithCertUsers maps the common names of the TLS client certificates to the
+// wallet identities.  Only the certificates verified by the TLS configuration of
+// the http.Server are considered, e.g., with tls.VerifyClientCertIfGiven.
+func WithCertUsers(users map[string]string) ServerOption {
+	return func(so *serverOptions) {
+		for cn, user := range users {
+			so.certs[cn] = user
+		}
+	}
+}
+
+// WithRequestTimeout bounds the duration of the calls.  The default is
+// DefaultRequestTimeout.  It does not apply to the event streams.
+func WithRequestTimeout(d time.Duration) ServerOption {
+	return func(so *serverOptions) {
+		so.timeout = d
+	}
+}
+
+// NewServer returns a Server using the Clients of `pool`.  It returns
+// ErrNoCredentials if neither API keys nor certificate users are defined.  The
+// pool is not closed with the Server.
+func NewServer(pool *ClientPool, options ...ServerOption) (*Server, error) {
+	so := serverOptions{keys: map[string]string{}, certs: map[string]string{}, timeout: DefaultRequestTimeout}
+	for _, option := range options {
+		option(&so)
+	}
+	if len(so.keys) == 0 && len(so.certs) == 0 {
+		return nil, ErrNoCredentials
+	}
+	return &Server{
+		pool:        pool,
+		keys:        so.keys,
+		certs:       so.certs,
+		timeout:     so.timeout,
+		mds:         make(map[string]mdEntry),
+		submitAsync: (*Contract).SubmitAsyncContext,
+		txStatus:    (*Client).TransactionStatus,
+		events:      (*Contract).ChaincodeEvents,
+	}, nil
+}
+
+// requestError is an error of the request reported with HTTP status `code`.
+type requestError struct {
+	code int
+	msg  string
+}
+
+func (e *requestError) Error() string {
+	return e.msg
+}
+
+func badRequest(format string, a ...interface{}) error {
+	return &requestError{code: http.StatusBadRequest, msg: fmt.Sprintf(format, a...)}
+}
+
+func notFound(format string, a ...interface{}) error {
+	return &requestError{code: http.StatusNotFound, msg: fmt.Sprintf(format, a...)}
+}
+
+// callResponse is the response of evaluate and submit.
+type callResponse struct {
+	TxID   string      `json:"txId,omitempty"`
+	Result interface{} `json:"result"`
+	Status *TxStatus   `json:"status,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	route, arg := strings.TrimPrefix(r.URL.Path, apiPrefix+"/"), ""
+	if route == r.URL.Path {
+		s.fail(w, "", notFound("no route %s", r.URL.Path))
+		return
+	}
+	if i := strings.Index(route, "/"); i >= 0 {
+		route, arg = route[:i], route[i+1:]
+	}
+	method := http.MethodGet
+	var handle func(w http.ResponseWriter, r *http.Request, c *Client, arg string) error
+	switch route {
+	case "evaluate":
+		method, handle = http.MethodPost, s.evaluate
+	case "submit":
+		method, handle = http.MethodPost, s.submit
+	case "transactions":
+		handle = s.transaction
+	case "events":
+		handle = s.streamEvents
+	case "openapi.json":
+		handle = s.openAPI
+	default:
+		s.fail(w, "", notFound("no route %s", r.URL.Path))
+		return
+	}
+	if r.Method != method {
+		w.Header().Set("Allow", method)
+		s.fail(w, "", &requestError{code: http.StatusMethodNotAllowed, msg: r.Method + " not allowed"})
+		return
+	}
+	user, ok := s.caller(r)
+	if !ok {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		s.fail(w, "", &requestError{code: http.StatusUnauthorized, msg: "unknown caller"})
+		return
+	}
+	c, err := s.pool.Get(user)
+	if err != nil {
+		s.fail(w, user, err)
+		return
+	}
+	defer s.pool.Release(user)
+	if err := handle(w, r, c, arg); err != nil {
+		s.fail(w, user, err)
+	}
+}
+
+// caller returns the wallet identity of the caller of `r`.
+func (s *Server) caller(r *http.Request) (string, bool) {
+	return s.identify(apiKey(r.Header.Get(apiKeyHeader), r.Header.Get("Authorization")), r.TLS)
+}
+
+// identify returns the wallet identity of the caller of API key `key`, or, if
+// `key` is empty, of the verified client certificate of the TLS connection
+// `state`.  `state` may be nil.
+func (s *Server) identify(key string, state *tls.ConnectionState) (string, bool) {
+	if key != "" {
+		user, ok := s.keys[hashKey(key)]
+		return user, ok
+	}
+	if state != nil && len(state.VerifiedChains) > 0 && len(state.VerifiedChains[0]) > 0 {
+		user, ok := s.certs[state.VerifiedChains[0][0].Subject.CommonName]
+		return user, ok
+	}
+	return "", false
+}
+
+// apiKey returns the API key of the header X-API-Key of value `key`, else of the
+// bearer token of the header Authorization of value `authorization`.
+func apiKey(key string, authorization string) string {
+	if key == "" && strings.HasPrefix(authorization, "Bearer ") {
+		key = strings.TrimSpace(strings.TrimPrefix(authorization, "Bearer "))
+	}
+	return key
+}
+
+// hashKey returns the digest under which the API key `key` is stored, so that
+// its lookup does not depend on the content of the key.
+func hashKey(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(h[:])
+}
+
+func (s *Server) evaluate(w http.ResponseWriter, r *http.Request, c *Client, fn string) error {
+	ct, args, err := s.call(w, r, c, fn)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), s.timeout)
+	defer cancel()
+	res, err := ct.QueryContext(ctx, fn, args...)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, callResponse{Result: result(res)})
+	return nil
+}
+
+func (s *Server) submit(w http.ResponseWriter, r *http.Request, c *Client, fn string) error {
+	async, err := queryBool(r.URL.Query(), "async")
+	if err != nil {
+		return err
+	}
+	ct, args, err := s.call(w, r, c, fn)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), s.timeout)
+	defer cancel()
+	h, err := s.submitAsync(ct, ctx, fn, args...)
+	if err != nil {
+		return err
+	}
+	resp := callResponse{TxID: h.TxID, Result: result(h.Payload)}
+	if async {
+		w.Header().Set("Location", apiPrefix+"/transactions/"+url.PathEscape(h.TxID))
+		writeJSON(w, http.StatusAccepted, resp)
+		return nil
+	}
+	code := http.StatusOK
+	resp.Status, err = h.Status(ctx)
+	if err != nil {
+		resp.Error = err.Error()
+		code = statusOf(err)
+		if resp.Status != nil && !resp.Status.Valid {
+			code = http.StatusConflict
+		}
+		// else the transaction may still commit: the caller polls its status.
+	}
+	writeJSON(w, code, resp)
+	return nil
+}
+
+// call returns the contract and the arguments of the call of `fn` of request
+// `r`.  The arguments are checked against the metadata of the chaincode, if any.
+func (s *Server) call(w http.ResponseWriter, r *http.Request, c *Client, fn string) (*Contract, []string, error) {
+	if fn == "" {
+		return nil, nil, notFound("no function")
+	}
+	var body struct {
+		Args []json.RawMessage `json:"args"`
+	}
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBody))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&body); err != nil && err != io.EOF {
+		return nil, nil, badRequest("invalid body: %v", err)
+	}
+	q := r.URL.Query()
+	ct, err := s.contract(c, q.Get("channel"), q.Get("chaincode"), q.Get("contract"))
+	if err != nil {
+		return nil, nil, err
+	}
+	if md := s.metadata(r.Context(), ct); md != nil {
+		if err := checkCall(md, ct, fn, body.Args); err != nil {
+			return nil, nil, err
+		}
+	}
+	args := make([]string, len(body.Args))
+	for i, a := range body.Args {
+		var str string
+		if json.Unmarshal(a, &str) == nil {
+			args[i] = str
+			continue
+		}
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, a); err != nil {
+			return nil, nil, badRequest("argument %d: %v", i, err)
+		}
+		args[i] = buf.String()
+	}
+	return ct, args, nil
+}
+
+// contract returns the contract `name` of chaincode `chaincode` of channel
+// `channel`.  The empty parameters default to the configuration of the Client.
+func (s *Server) contract(c *Client, channel string, chaincode string, name string) (*Contract, error) {
+	if channel == "" {
+		channel = c.cfg.ChannelID
+	}
+	if chaincode == "" {
+		chaincode = c.cfg.ChainCodeID
+	}
+	if channel == c.cfg.ChannelID && chaincode == c.cfg.ChainCodeID && name == "" {
+		return c.mainContract(), nil
+	}
+	return c.Contract(channel, chaincode, name)
+}
+
+// metadata returns the metadata of the chaincode of `ct`, or nil if the
+// chaincode does not expose them.  The answers of the chaincode are cached for
+// metadataTTL; the other failures, e.g., an unavailable peer, are not.
+func (s *Server) metadata(ctx context.Context, ct *Contract) *Metadata {
+	key := ct.channel + "/" + ct.chaincode
+	s.mdMu.Lock()
+	e, ok := s.mds[key]
+	s.mdMu.Unlock()
+	if ok && time.Now().Before(e.expires) {
+		return e.md
+	}
+	e = mdEntry{expires: time.Now().Add(metadataTTL)}
+	// the metadata are served by the system contract, not by a named contract.
+	var err error
+	mdc := ct
+	if ct.name != "" {
+		mdc, err = ct.c.Contract(ct.channel, ct.chaincode)
+	}
+	var data []byte
+	if err == nil {
+		data, err = mdc.QueryContext(ctx, MetadataFunction)
+	}
+	switch {
+	case err == nil:
+		if e.md, err = ParseMetadata(data); err != nil {
+			Logr.Debugf("server: no metadata for %s: %v", key, err)
+		}
+	case isChaincodeError(err):
+		Logr.Debugf("server: no metadata for %s: %v", key, err)
+	default:
+		Logr.Warnf("server: could not query metadata of %s: %v", key, err)
+		return nil
+	}
+	s.mdMu.Lock()
+	s.mds[key] = e
+	s.mdMu.Unlock()
+	return e.md
+}
+
+// isChaincodeError returns true if `err` is the answer of the chaincode, e.g.,
+// an unknown function.
+func isChaincodeError(err error) bool {
+	return matchStatus(err, func(s *status.Status) bool { return s.Group == status.ChaincodeStatus })
+}
+
+// checkCall checks the function `fn` of `ct` and its arguments `args` against
+// the metadata `md`.
+func checkCall(md *Metadata, ct *Contract, fn string, args []json.RawMessage) error {
+	tx, err := lookupTx(md, ct, fn)
+	if err != nil {
+		return err
+	}
+	return checkArgs(tx, fn, args)
+}
+
+// lookupTx returns the metadata of the function `fn` of `ct`.
+func lookupTx(md *Metadata, ct *Contract, fn string) (*TransactionMetadata, error) {
+	name := fn
+	if ct.name != "" && !strings.Contains(fn, ":") {
+		name = ct.name + ":" + fn
+	}
+	_, tx := md.Transaction(name)
+	if tx == nil {
+		return nil, notFound("unknown function %s", fn)
+	}
+	return tx, nil
+}
+
+// checkArgs checks the arguments `args` of the function `fn` of metadata `tx`.
+func checkArgs(tx *TransactionMetadata, fn string, args []json.RawMessage) error {
+	if len(args) != len(tx.Parameters) {
+		return badRequest("%s expects %d arguments, not %d", fn, len(tx.Parameters), len(args))
+	}
+	for i, p := range tx.Parameters {
+		if err := checkArg(p.Schema, args[i]); err != nil {
+			return badRequest("argument %d (%s) of %s: %v", i, p.Name, fn, err)
+		}
+	}
+	return nil
+}
+
+// checkArg checks that the JSON value `arg` has the type of schema `s`.
+func checkArg(s *Schema, arg json.RawMessage) error {
+	if s == nil {
+		return nil
+	}
+	want := s.Type.Main()
+	if s.RefName() != "" {
+		want = "object"
+	}
+	raw := bytes.TrimSpace(arg)
+	if !json.Valid(raw) {
+		return errors.New("invalid JSON")
+	}
+	var got string
+	switch raw[0] {
+	case '"':
+		got = "string"
+	case '{':
+		got = "object"
+	case '[':
+		got = "array"
+	case 't', 'f':
+		got = "boolean"
+	case 'n':
+		got = "null"
+	default:
+		got = "number"
+	}
+	switch {
+	case want == "" || want == got:
+		return nil
+	case got == "null":
+		for _, t := range s.Type {
+			if t == "null" {
+				return nil
+			}
+		}
+	case want == "integer" && got == "number":
+		if _, err := strconv.ParseInt(string(raw), 10, 64); err == nil {
+			return nil
+		}
+		return fmt.Errorf("%s is not an integer", raw)
+	}
+	return fmt.Errorf("expected %s, got %s", want, got)
+}
+
+func (s *Server) transaction(w http.ResponseWriter, r *http.Request, c *Client, txID string) error {
+	if txID == "" {
+		return notFound("no transaction")
+	}
+	st, err := s.txStatus(c, txID)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, st)
+	return nil
+}
+
+// streamEvents streams the chaincode events as server-sent events.  The id of
+// an event is its block number.  A client reconnecting with the header
+// Last-Event-ID resumes with this block, thus may receive again some events.
+func (s *Server) streamEvents(w http.ResponseWriter, r *http.Request, c *Client, arg string) error {
+	if arg != "" {
+		return notFound("no route %s", r.URL.Path)
+	}
+	fl, ok := w.(http.Flusher)
+	if !ok {
+		return errors.New("streaming not supported")
+	}
+	q := r.URL.Query()
+	var opts []EventOption
+	from := q.Get("from")
+	if id := r.Header.Get("Last-Event-ID"); id != "" {
+		from = id
+	}
+	if from != "" {
+		n, err := strconv.ParseUint(from, 10, 64)
+		if err != nil {
+			return badRequest("invalid start block %q", from)
+		}
+		opts = append(opts, WithStartBlock(n))
+	}
+	ct, err := s.contract(c, q.Get("channel"), q.Get("chaincode"), q.Get("contract"))
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	events, err := s.events(ct, ctx, q.Get("filter"), opts...)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	fl.Flush()
+	ticker := time.NewTicker(sseHeartbeat)
+	defer ticker.Stop()
+	for {
+		var err error
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return nil
+			}
+			data, _ := json.Marshal(ev)
+			name := strings.NewReplacer("\r", " ", "\n", " ").Replace(ev.Name)
+			_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", ev.BlockNumber, name, data)
+		case <-ticker.C:
+			_, err = io.WriteString(w, ": ping\n\n")
+		}
+		if err != nil {
+			// the caller left.
+			return nil
+		}
+		fl.Flush()
+	}
+}
+
+func (s *Server) openAPI(w http.ResponseWriter, r *http.Request, c *Client, arg string) error {
+	if arg != "" {
+		return notFound("no route %s", r.URL.Path)
+	}
+	q := r.URL.Query()
+	ct, err := s.contract(c, q.Get("channel"), q.Get("chaincode"), q.Get("contract"))
+	if err != nil {
+		return err
+	}
+	md := s.metadata(r.Context(), ct)
+	if md == nil {
+		return notFound("chaincode %s has no metadata", ct.chaincode)
+	}
+	writeJSON(w, http.StatusOK, md.OpenAPI(ct.chaincode))
+	return nil
+}
+
+// fail reports the error `err` of the call of `user`.
+func (s *Server) fail(w http.ResponseWriter, user string, err error) {
+	code := statusOf(err)
+	if code >= http.StatusInternalServerError {
+		Logr.WithField(LogFieldUser, user).Warnf("server: %v", err)
+	}
+	writeJSON(w, code, map[string]string{"error": err.Error()})
+}
+
+// statusOf returns the HTTP status reporting `err`.
+func statusOf(err error) int {
+	var re *requestError
+	switch {
+	case errors.As(err, &re):
+		return re.code
+	case errors.Is(err, ErrTxNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrRateLimited), errors.Is(err, ErrPoolFull):
+		return http.StatusTooManyRequests
+	case errors.Is(err, ErrCircuitOpen), errors.Is(err, ErrClientClosed), errors.Is(err, ErrPoolClosed),
+		errors.Is(err, ErrClientNotInitialized):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, ErrInvalidContractName), errors.Is(err, ErrQualifiedFunction):
+		return http.StatusBadRequest
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	}
+	// the peers, the orderer or the chaincode failed.
+	return http.StatusBadGateway
+}
+
+// queryBool returns the boolean query parameter `name` of `q`, false if absent.
+func queryBool(q url.Values, name string) (bool, error) {
+	v := q.Get(name)
+	if v == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, badRequest("invalid %s %q", name, v)
+	}
+	return b, nil
+}
+
+// result returns the payload `res` as JSON if it is valid JSON, else as a
+// string.
+func result(res []byte) interface{} {
+	if len(res) == 0 {
+		return nil
+	}
+	if json.Valid(res) {
+		return json.RawMessage(res)
+	}
+	return string(res)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,333 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/errors/status"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/stretchr/testify/require"
+)
+
+// testServer returns a Server on fake Clients knowing the metadata of the test
+// data.  The API key "k1" is user1 and the certificate "app1" is user2.  The
+// submitted transactions are committed with `code`.
+func testServer(t *testing.T, code *peer.TxValidationCode) (*Server, *[]string) {
+	pool := newClientPool(poolOptions{maxSize: 4}, func(user string) (*Client, error) {
+		c := fakeClient(&fakeContract{})
+		c.cfg.User = user
+		return c, nil
+	})
+	t.Cleanup(pool.Close)
+	s, err := NewServer(pool, WithAPIKeys(map[string]string{"k1": "user1"}),
+		WithCertUsers(map[string]string{"app1": "user2"}))
+	require.NoError(t, err)
+
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "metadata.json"))
+	require.NoError(t, err)
+	md, err := ParseMetadata(data)
+	require.NoError(t, err)
+	s.mds["mychannel/fabcar"] = mdEntry{md: md, expires: time.Now().Add(time.Hour)}
+
+	var submitted []string
+	s.submitAsync = func(ct *Contract, ctx context.Context, fn string, args ...string) (*SubmitHandle, error) {
+		submitted = append(submitted, fn+"("+strings.Join(args, "|")+")")
+		notifier := make(chan *fab.TxStatusEvent, 1)
+		if code != nil {
+			notifier <- &fab.TxStatusEvent{TxID: "tx1", TxValidationCode: *code, BlockNumber: 7}
+		}
+		return watchCommit("tx1", []byte(`{"done":true}`), notifier, func() {}, time.Minute, nil), nil
+	}
+	s.txStatus = func(c *Client, txID string) (*TxStatus, error) {
+		if txID != "tx1" {
+			return nil, ErrTxNotFound
+		}
+		return &TxStatus{TxID: txID, ValidationCode: "VALID", Valid: true}, nil
+	}
+	return s, &submitted
+}
+
+// serve sends the request to `s` with the API key `key` and returns the status
+// and the body of the response.
+func serve(s *Server, method string, path string, key string, body string) (int, string) {
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	if key != "" {
+		r.Header.Set(apiKeyHeader, key)
+	}
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, r)
+	return w.Code, w.Body.String()
+}
+
+func Test_NewServer(t *testing.T) {
+	_, err := NewServer(nil)
+	require.ErrorIs(t, err, ErrNoCredentials)
+}
+
+func Test_Server_Caller(t *testing.T) {
+	require := require.New(t)
+	s, _ := testServer(t, nil)
+
+	code, body := serve(s, http.MethodPost, "/v1/evaluate/CountCars", "", "")
+	require.Equal(http.StatusUnauthorized, code)
+	require.JSONEq(`{"error": "unknown caller"}`, body)
+	code, _ = serve(s, http.MethodPost, "/v1/evaluate/CountCars", "k2", "")
+	require.Equal(http.StatusUnauthorized, code)
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/evaluate/CountCars", nil)
+	r.Header.Set("Authorization", "Bearer k1")
+	user, ok := s.caller(r)
+	require.True(ok)
+	require.Equal("user1", user)
+
+	r = httptest.NewRequest(http.MethodPost, "/v1/evaluate/CountCars", nil)
+	r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: "app1"}}}}}
+	user, ok = s.caller(r)
+	require.True(ok)
+	require.Equal("user2", user)
+	// not verified
+	r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "app1"}}}}
+	_, ok = s.caller(r)
+	require.False(ok)
+}
+
+func Test_Server_Evaluate(t *testing.T) {
+	require := require.New(t)
+	s, _ := testServer(t, nil)
+
+	code, body := serve(s, http.MethodPost, "/v1/evaluate/QueryCar", "k1", `{"args": ["CAR1"]}`)
+	require.Equal(http.StatusOK, code)
+	require.JSONEq(`{"result": "QueryCar"}`, body)
+
+	for _, tc := range []struct {
+		path, body string
+		code       int
+		msg        string
+	}{
+		{"/v1/evaluate/QueryCar", `{"args": []}`, http.StatusBadRequest, "expects 1 arguments"},
+		{"/v1/evaluate/QueryCar", `{"args": [1]}`, http.StatusBadRequest, "expected string, got number"},
+		{"/v1/evaluate/QueryCar", `{"arg": ["CAR1"]}`, http.StatusBadRequest, "invalid body"},
+		{"/v1/evaluate/QueryCar", `{"args": [`, http.StatusBadRequest, "invalid body"},
+		{"/v1/evaluate/Unknown", ``, http.StatusNotFound, "unknown function Unknown"},
+		{"/v1/evaluate/", ``, http.StatusNotFound, "no function"},
+		{"/v1/unknown", ``, http.StatusNotFound, "no route"},
+		{"/evaluate/QueryCar", ``, http.StatusNotFound, "no route"},
+	} {
+		code, body = serve(s, http.MethodPost, tc.path, "k1", tc.body)
+		require.Equal(tc.code, code, tc.path+" "+tc.body)
+		require.Contains(body, tc.msg, tc.path+" "+tc.body)
+	}
+	code, _ = serve(s, http.MethodGet, "/v1/evaluate/QueryCar", "k1", "")
+	require.Equal(http.StatusMethodNotAllowed, code)
+
+	// without metadata, the call is not checked.
+	s.mds = map[string]mdEntry{}
+	code, body = serve(s, http.MethodPost, "/v1/evaluate/Unknown", "k1", "")
+	require.Equal(http.StatusOK, code)
+	require.JSONEq(`{"result": "Unknown"}`, body)
+	require.Nil(s.mds["mychannel/fabcar"].md)
+}
+
+func Test_Server_metadata(t *testing.T) {
+	require := require.New(t)
+	s, _ := testServer(t, nil)
+	s.mds = map[string]mdEntry{}
+	c, err := s.pool.Get("user1")
+	require.NoError(err)
+	f := c.contract.(*fakeContract)
+	s.pool.Release("user1")
+
+	// the failures of the network are not cached.
+	f.err = errors.New("rpc error: code = Unavailable desc = connection refused")
+	require.Nil(s.metadata(context.Background(), c.mainContract()))
+	require.NotContains(s.mds, "mychannel/fabcar")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Nil(s.metadata(ctx, c.mainContract()))
+	require.NotContains(s.mds, "mychannel/fabcar")
+
+	// the chaincode without metadata is.
+	f.err = status.New(status.ChaincodeStatus, 500, "Invalid function org.hyperledger.fabric:GetMetadata", nil)
+	require.Nil(s.metadata(context.Background(), c.mainContract()))
+	require.Contains(s.mds, "mychannel/fabcar")
+	require.Nil(s.mds["mychannel/fabcar"].md)
+}
+
+func Test_Server_Submit(t *testing.T) {
+	require := require.New(t)
+	valid, mvcc := peer.TxValidationCode_VALID, peer.TxValidationCode_MVCC_READ_CONFLICT
+	s, submitted := testServer(t, &valid)
+
+	code, body := serve(s, http.MethodPost, "/v1/submit/AuditContract:Record", "k1",
+		`{"args": ["check", {"make": "VW",  "owner": "Jo"}, 4.5]}`)
+	require.Equal(http.StatusOK, code, body)
+	require.JSONEq(`{"txId": "tx1", "result": {"done": true}, "status": {"txId": "tx1", "blockNumber": 7,
+		"validationCode": "VALID", "valid": true}}`, body)
+	require.Equal([]string{`AuditContract:Record(check|{"make":"VW","owner":"Jo"}|4.5)`}, *submitted)
+
+	code, body = serve(s, http.MethodPost, "/v1/submit/AuditContract:Record", "k1", `{"args": ["check", "VW", 4.5]}`)
+	require.Equal(http.StatusBadRequest, code)
+	require.Contains(body, "expected object, got string")
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/submit/ChangeCarOwner?async=true", strings.NewReader(
+		`{"args": ["CAR1", "Jo"]}`))
+	r.Header.Set(apiKeyHeader, "k1")
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, r)
+	require.Equal(http.StatusAccepted, w.Code)
+	require.Equal("/v1/transactions/tx1", w.Header().Get("Location"))
+	require.JSONEq(`{"txId": "tx1", "result": {"done": true}}`, w.Body.String())
+	code, _ = serve(s, http.MethodPost, "/v1/submit/ChangeCarOwner?async=maybe", "k1", `{"args": ["CAR1", "Jo"]}`)
+	require.Equal(http.StatusBadRequest, code)
+
+	s, _ = testServer(t, &mvcc)
+	code, body = serve(s, http.MethodPost, "/v1/submit/ChangeCarOwner", "k1", `{"args": ["CAR1", "Jo"]}`)
+	require.Equal(http.StatusConflict, code)
+	require.Contains(body, `"validationCode":"MVCC_READ_CONFLICT"`)
+
+	// never committed
+	s, _ = testServer(t, nil)
+	s.timeout = 10 * time.Millisecond
+	code, body = serve(s, http.MethodPost, "/v1/submit/ChangeCarOwner", "k1", `{"args": ["CAR1", "Jo"]}`)
+	require.Equal(http.StatusGatewayTimeout, code)
+	require.Contains(body, `"txId":"tx1"`)
+}
+
+func Test_Server_ContractName(t *testing.T) {
+	require := require.New(t)
+	s, _ := testServer(t, nil)
+
+	// the SDK names the default contract after the chaincode.
+	c, err := s.pool.Get("user1")
+	require.NoError(err)
+	require.Equal("fabcar", c.contract.Name())
+	code, body := serve(s, http.MethodPost, "/v1/evaluate/QueryCar", "k1", `{"args": ["CAR1"]}`)
+	require.Equal(http.StatusOK, code, body)
+
+	c.cfg.ContractName = "AuditContract"
+	c.contract = &fakeContract{name: "fabcar:AuditContract"}
+	s.pool.Release("user1")
+	code, body = serve(s, http.MethodPost, "/v1/evaluate/Record", "k1", `{"args": ["check", {"make": "VW"}, 4.5]}`)
+	require.Equal(http.StatusOK, code, body)
+	require.JSONEq(`{"result": "Record"}`, body)
+	code, _ = serve(s, http.MethodPost, "/v1/evaluate/QueryCar", "k1", `{"args": ["CAR1"]}`)
+	require.Equal(http.StatusNotFound, code)
+}
+
+func Test_Server_Transaction(t *testing.T) {
+	require := require.New(t)
+	s, _ := testServer(t, nil)
+
+	code, body := serve(s, http.MethodGet, "/v1/transactions/tx1", "k1", "")
+	require.Equal(http.StatusOK, code)
+	require.JSONEq(`{"txId": "tx1", "blockNumber": 0, "validationCode": "VALID", "valid": true}`, body)
+	code, _ = serve(s, http.MethodGet, "/v1/transactions/tx2", "k1", "")
+	require.Equal(http.StatusNotFound, code)
+}
+
+func Test_Server_Events(t *testing.T) {
+	require := require.New(t)
+	s, _ := testServer(t, nil)
+	var from uint64
+	var filter string
+	s.events = func(ct *Contract, ctx context.Context, f string, opts ...EventOption) (<-chan *ChaincodeEvent, error) {
+		filter = f
+		eo := eventOptions{}
+		for _, option := range opts {
+			option(&eo)
+		}
+		from = eo.from
+		ch := make(chan *ChaincodeEvent, 2)
+		ch <- &ChaincodeEvent{Chaincode: "fabcar", TxID: "tx1", Name: "CarCreated", BlockNumber: 7}
+		ch <- &ChaincodeEvent{Chaincode: "fabcar", TxID: "tx2", Name: "CarSold", BlockNumber: 8}
+		close(ch)
+		return ch, nil
+	}
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+
+	req, err := http.NewRequest(http.MethodGet, ts.URL+"/v1/events?filter=Car.*&from=3", nil)
+	require.NoError(err)
+	req.Header.Set(apiKeyHeader, "k1")
+	req.Header.Set("Last-Event-ID", "5")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(err)
+	defer resp.Body.Close()
+	require.Equal(http.StatusOK, resp.StatusCode)
+	require.Equal("text/event-stream", resp.Header.Get("Content-Type"))
+	var lines []string
+	sc := bufio.NewScanner(resp.Body)
+	for sc.Scan() {
+		lines = append(lines, sc.Text())
+	}
+	require.EqualValues(5, from)
+	require.Equal("Car.*", filter)
+	require.Equal([]string{"id: 7", "event: CarCreated",
+		`data: {"chaincode":"fabcar","txId":"tx1","name":"CarCreated","blockNumber":7}`, "",
+		"id: 8", "event: CarSold",
+		`data: {"chaincode":"fabcar","txId":"tx2","name":"CarSold","blockNumber":8}`, ""}, lines)
+
+	code, _ := serve(s, http.MethodGet, "/v1/events?from=x", "k1", "")
+	require.Equal(http.StatusBadRequest, code)
+}
+
+func Test_Server_OpenAPI(t *testing.T) {
+	require := require.New(t)
+	s, _ := testServer(t, nil)
+
+	code, body := serve(s, http.MethodGet, "/v1/openapi.json", "k1", "")
+	require.Equal(http.StatusOK, code)
+	var doc map[string]interface{}
+	require.NoError(json.Unmarshal([]byte(body), &doc))
+	require.Contains(doc["paths"], "/evaluate/QueryCar")
+
+	s.mds["mychannel/fabcar"] = mdEntry{expires: time.Now().Add(time.Hour)}
+	code, _ = serve(s, http.MethodGet, "/v1/openapi.json", "k1", "")
+	require.Equal(http.StatusNotFound, code)
+}
+
+func Test_checkArg(t *testing.T) {
+	str := &Schema{Type: SchemaType{"string"}}
+	integer := &Schema{Type: SchemaType{"integer"}}
+	nullable := &Schema{Type: SchemaType{"null", "number"}}
+	ref := &Schema{Ref: "#/components/schemas/Car"}
+	for _, tc := range []struct {
+		s   *Schema
+		arg string
+		ok  bool
+	}{
+		{str, `"a"`, true}, {str, `1`, false}, {str, `null`, false},
+		{integer, `12`, true}, {integer, `1.5`, false}, {integer, `"1"`, false},
+		{nullable, `null`, true}, {nullable, `-1e3`, true}, {nullable, `true`, false},
+		{ref, `{"make": "VW"}`, true}, {ref, `[]`, false},
+		{&Schema{}, `[1]`, true}, {nil, `{}`, true},
+	} {
+		err := checkArg(tc.s, json.RawMessage(tc.arg))
+		require.Equal(t, tc.ok, err == nil, fmt.Sprintf("%v %s: %v", tc.s, tc.arg, err))
+	}
+}
+
+func Test_statusOf(t *testing.T) {
+	require.Equal(t, http.StatusTooManyRequests, statusOf(fmt.Errorf("submit: %w", ErrRateLimited)))
+	require.Equal(t, http.StatusServiceUnavailable, statusOf(ErrCircuitOpen))
+	require.Equal(t, http.StatusGatewayTimeout, statusOf(context.DeadlineExceeded))
+	require.Equal(t, http.StatusBadGateway, statusOf(fmt.Errorf("chaincode failed")))
+}
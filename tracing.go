@@ -0,0 +1,179 @@
+// v0.4.0
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/hyperledger/fabric-sdk-go/pkg/gateway"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the OpenTelemetry tracer of the Client.
+const TracerName = "blockchain"
+
+// Attributes of the spans of the Client.
+const (
+	attrChannel   = attribute.Key("fabric.channel")
+	attrChaincode = attribute.Key("fabric.chaincode")
+	attrContract  = attribute.Key("fabric.contract")
+	attrFunction  = attribute.Key("fabric.function")
+	attrTxID      = attribute.Key("fabric.tx_id")
+	attrPeer      = attribute.Key("fabric.peer")
+	attrBlock     = attribute.Key("fabric.block")
+	attrCode      = attribute.Key("fabric.validation_code")
+)
+
+// noopTracer is the tracer of the Clients without TracerProvider.
+var noopTracer = trace.NewNoopTracerProvider().Tracer(TracerName)
+
+// txCreator creates the transactions of a contract, e.g., gatewayContract.  It
+// allows to pass the trace context in the transient map and to get the commit
+// event.
+type txCreator interface {
+	newTransaction(name string, transient map[string][]byte) (transaction, error)
+}
+
+// transaction is the part of gateway.Transaction used by the Client.
+type transaction interface {
+	Evaluate(args ...string) ([]byte, error)
+	Submit(args ...string) ([]byte, error)
+	RegisterCommitEvent() <-chan *fab.TxStatusEvent
+}
+
+// gatewayContract is a gateway.Contract creating its transactions for the
+// Client.
+type gatewayContract struct {
+	*gateway.Contract
+}
+
+func (gc gatewayContract) newTransaction(name string, transient map[string][]byte) (transaction, error) {
+	txn, err := gc.CreateTransaction(name, gateway.WithTransient(transient))
+	if err != nil {
+		return nil, err
+	}
+	return txn, nil
+}
+
+// WithTracerProvider traces the transactions of the Client with a tracer of
+// `tp`.  By default, the Client does not trace.
+func WithTracerProvider(tp trace.TracerProvider) ClientOption {
+	return func(cp *clientOptions) {
+		cp.tracerProvider = tp
+	}
+}
+
+// WithPropagator sets the propagator injecting the trace context into the
+// transient map of the transactions.  The default is the W3C trace context, i.e.,
+// the transient keys "traceparent" and "tracestate".
+func WithPropagator(p propagation.TextMapPropagator) ClientOption {
+	return func(cp *clientOptions) {
+		cp.propagator = p
+	}
+}
+
+// startSpan starts the span `name` of a transaction of `ct`.
+func (c *Client) startSpan(ctx context.Context, name string, ct *Contract, fn string) (context.Context, trace.Span) {
+	t := c.tracer
+	if t == nil {
+		t = noopTracer
+	}
+	attrs := []attribute.KeyValue{attrChannel.String(ct.channel), attrChaincode.String(ct.chaincode),
+		attrFunction.String(fn)}
+	if ct.name != "" {
+		attrs = append(attrs, attrContract.String(ct.name))
+	}
+	return t.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// endSpan ends `span` recording `err`.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// mapCarrier is a propagation.TextMapCarrier on a map.
+type mapCarrier map[string]string
+
+func (m mapCarrier) Get(key string) string {
+	return m[key]
+}
+
+func (m mapCarrier) Set(key string, value string) {
+	m[key] = value
+}
+
+func (m mapCarrier) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// traceTransient returns the transient map carrying the trace context of `ctx`,
+// or nil if there is none.
+func (c *Client) traceTransient(ctx context.Context) map[string][]byte {
+	p := c.propagator
+	if p == nil {
+		p = propagation.TraceContext{}
+	}
+	carrier := mapCarrier{}
+	p.Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+	transient := make(map[string][]byte, len(carrier))
+	for k, v := range carrier {
+		transient[k] = []byte(v)
+	}
+	return transient
+}
+
+// transact submits, or evaluates, the transaction `fn` of `ct`.  When `ctx`
+// carries a trace context, it is propagated in the transient map, and the TxID
+// of the submitted transaction is returned and added with its peer to the span
+// of `ctx`.
+func (c *Client) transact(ctx context.Context, ct *Contract, submit bool, fn string,
+	args []string) ([]byte, string, error) {
+	creator, ok := ct.contract.(txCreator)
+	transient := c.traceTransient(ctx)
+	if !ok || transient == nil {
+		if submit {
+			res, err := ct.contract.SubmitTransaction(fn, args...)
+			return res, "", err
+		}
+		res, err := ct.contract.EvaluateTransaction(fn, args...)
+		return res, "", err
+	}
+	txn, err := creator.newTransaction(fn, transient)
+	if err != nil {
+		return nil, "", err
+	}
+	if !submit {
+		res, err := txn.Evaluate(args...)
+		return res, "", err
+	}
+	events := txn.RegisterCommitEvent()
+	res, err := txn.Submit(args...)
+	var txID string
+	select {
+	case ev := <-events:
+		if ev != nil {
+			txID = ev.TxID
+			trace.SpanFromContext(ctx).SetAttributes(attrTxID.String(ev.TxID), attrPeer.String(ev.SourceURL),
+				attrBlock.Int64(int64(ev.BlockNumber)), attrCode.String(ev.TxValidationCode.String()))
+		}
+	default:
+	}
+	return res, txID, err
+}
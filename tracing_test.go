@@ -0,0 +1,164 @@
+// v0.1.1
+// Author: DIEHL E.
+// (C) Sony Pictures Entertainment, Oct 2026
+
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func tracedClient(f *fakeContract) (*Client, *tracetest.SpanRecorder) {
+	sr := tracetest.NewSpanRecorder()
+	c := fakeClient(f)
+	c.tracer = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)).Tracer(TracerName)
+	return c, sr
+}
+
+func spanAttr(s sdktrace.ReadOnlySpan, key attribute.Key) string {
+	for _, kv := range s.Attributes() {
+		if kv.Key == key {
+			return kv.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func Test_Client_Tracing(t *testing.T) {
+	require := require.New(t)
+
+	f := &fakeContract{name: "fabcar:AuditContract"}
+	c, sr := tracedClient(f)
+	c.cfg.ContractName = "AuditContract"
+	_, err := c.InvokeContext(context.Background(), "Record", "a")
+	require.NoError(err)
+	f.err = errors.New("car CAR1 does not exist")
+	_, err = c.QueryContext(context.Background(), "QueryCar", "CAR1")
+	require.Error(err)
+
+	spans := sr.Ended()
+	require.Len(spans, 2)
+	require.Equal("fabric.submit", spans[0].Name())
+	require.Equal("mychannel", spanAttr(spans[0], attrChannel))
+	require.Equal("fabcar", spanAttr(spans[0], attrChaincode))
+	require.Equal("AuditContract", spanAttr(spans[0], attrContract))
+	require.Equal("Record", spanAttr(spans[0], attrFunction))
+	require.Equal(codes.Unset, spans[0].Status().Code)
+	require.Equal("fabric.evaluate", spans[1].Name())
+	require.Equal(codes.Error, spans[1].Status().Code)
+	require.Equal(f.err.Error(), spans[1].Status().Description)
+}
+
+// fakeTxContract is a fakeContract creating its transactions as gatewayContract.
+type fakeTxContract struct {
+	*fakeContract
+	transient map[string][]byte
+}
+
+func (f *fakeTxContract) newTransaction(name string, transient map[string][]byte) (transaction, error) {
+	f.transient = transient
+	return &fakeTransaction{f: f.fakeContract, name: name}, nil
+}
+
+// fakeTransaction is a transaction of fakeTxContract committed in block 7.
+type fakeTransaction struct {
+	f      *fakeContract
+	name   string
+	events chan *fab.TxStatusEvent
+}
+
+func (txn *fakeTransaction) Evaluate(args ...string) ([]byte, error) {
+	return txn.f.call(txn.name)
+}
+
+func (txn *fakeTransaction) Submit(args ...string) ([]byte, error) {
+	res, err := txn.f.call(txn.name)
+	if err == nil && txn.events != nil {
+		txn.events <- &fab.TxStatusEvent{TxID: "tx1", TxValidationCode: peer.TxValidationCode_VALID,
+			BlockNumber: 7, SourceURL: "peer0.org1.example.com:7051"}
+		close(txn.events)
+	}
+	return res, err
+}
+
+func (txn *fakeTransaction) RegisterCommitEvent() <-chan *fab.TxStatusEvent {
+	txn.events = make(chan *fab.TxStatusEvent, 1)
+	return txn.events
+}
+
+func Test_Client_Tracing_Transaction(t *testing.T) {
+	require := require.New(t)
+
+	f := &fakeTxContract{fakeContract: &fakeContract{}}
+	c, sr := tracedClient(f.fakeContract)
+	c.contract = f
+	// without tracer, there is no trace context and the transactions of the
+	// contract are not created.
+	tracer := c.tracer
+	c.tracer = nil
+	_, err := c.Invoke("CreateCar", "CAR1")
+	require.NoError(err)
+	require.Nil(f.transient)
+	c.tracer = tracer
+
+	ctx, parent := c.startSpan(context.Background(), "parent", c.mainContract(), "")
+	res, err := c.InvokeContext(ctx, "CreateCar", "CAR1")
+	parent.End()
+	require.NoError(err)
+	require.Equal("CreateCar", string(res))
+	require.Contains(f.transient, "traceparent")
+	span := sr.Ended()[0]
+	require.Equal("fabric.submit", span.Name())
+	require.Equal("tx1", spanAttr(span, attrTxID))
+	require.Equal("peer0.org1.example.com:7051", spanAttr(span, attrPeer))
+	require.Equal("7", spanAttr(span, attrBlock))
+	require.Equal("VALID", spanAttr(span, attrCode))
+
+	f.transient = nil
+	ctx, parent = c.startSpan(context.Background(), "parent", c.mainContract(), "")
+	res, err = c.QueryContext(ctx, "QueryCar", "CAR1")
+	parent.End()
+	require.NoError(err)
+	require.Equal("QueryCar", string(res))
+	require.Contains(f.transient, "traceparent")
+	require.EqualValues(3, f.calls)
+}
+
+func Test_Client_traceTransient(t *testing.T) {
+	require := require.New(t)
+
+	c, _ := tracedClient(&fakeContract{})
+	require.Nil(c.traceTransient(context.Background()))
+
+	ctx, span := c.startSpan(context.Background(), "test", c.mainContract(), "fn")
+	defer span.End()
+	transient := c.traceTransient(ctx)
+	sc := span.SpanContext()
+	require.Equal("00-"+sc.TraceID().String()+"-"+sc.SpanID().String()+"-01", string(transient["traceparent"]))
+}
+
+func Test_Client_Retry_Context(t *testing.T) {
+	require := require.New(t)
+
+	f := &fakeContract{err: errors.New("rpc error: code = Unavailable")}
+	c := fakeClient(f)
+	c.retry = RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Hour}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := c.QueryContext(ctx, "queryCar")
+	var re *RetryError
+	require.True(errors.As(err, &re))
+	require.ErrorIs(err, context.DeadlineExceeded)
+	require.EqualValues(1, f.calls)
+}
@@ -1,4 +1,4 @@
-// V 0.6.4
+// V 0.8.1
 // Author: DIEHL E.
 // (C) Sony Pictures Entertainment, Nov 2020
 
@@ -6,16 +6,24 @@ package blockchain
 
 import (
 	"encoding/hex"
+	"sync"
+	"time"
 
 	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
 	"github.com/hyperledger/fabric-sdk-go/pkg/client/event"
 	"github.com/hyperledger/fabric-sdk-go/pkg/client/msp"
 	"github.com/hyperledger/fabric-sdk-go/pkg/client/resmgmt"
 	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/viper"
 )
 
 // FabricSetup implementation of the fabric-sdk-go interface
+//
+// InitUser may be called concurrently with the other methods.  The calls to
+// InitUser are serialized, and the channel and event clients of the current
+// user are swapped atomically.  Goroutines acting as different users should
+// use their own FabricSetup.
 type FabricSetup struct {
 	Configuration
 	// initialized is true if it the SDK was initializes fully.  In that case, initializedLite
@@ -29,6 +37,12 @@ type FabricSetup struct {
 	resMgmtClient *resmgmt.Client // used to manage channels
 	sdk           *fabsdk.FabricSDK
 	event         *event.Client
+
+	mu sync.RWMutex // protects currentUser, client and event.
+	// connect creates the clients of a user.  nil means connectUser.  It allows
+	// to replace the SDK by a fake backend in tests.
+	connect func(name string, secret ...string) (*channel.Client, *event.Client, error)
+	metrics *clientMetrics // nil if disabled
 }
 
 // // Attribute is a typical Key/Value structure to define optional
@@ -142,11 +156,64 @@ type FabricSetup struct {
 // It should have been registered by the consortium previously.
 func (fs *FabricSetup) InitUser(name string, secret ...string) error {
 	Logr.Debugf("FabricSet.InitUser entered for %s", name)
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
 	if name == fs.currentUser {
 		// already the right client
 		return nil
 	}
 
+	if len(secret) > 0 {
+		RedactSecret(secret[0])
+	}
+	connect := fs.connect
+	if connect == nil {
+		connect = fs.connectUser
+	}
+	start := time.Now()
+	client, ev, err := connect(name, secret...)
+	fs.metrics.call("setup", "InitUser", time.Since(start), err)
+	if err != nil {
+		return err
+	}
+
+	// Everyting is OK.
+	fs.client, fs.event, fs.currentUser = client, ev, name
+	Logr.Debugf("FabricSet.InitUser succeded for %s", fs.currentUser)
+	return nil
+}
+
+// SetMetrics registers the Prometheus metrics of the FabricSetup operations in
+// `reg`.  They are shared with the Clients using the same registerer.
+func (fs *FabricSetup) SetMetrics(reg prometheus.Registerer) error {
+	m, err := newClientMetrics(reg)
+	if err != nil {
+		return err
+	}
+	fs.mu.Lock()
+	fs.metrics = m
+	fs.mu.Unlock()
+	return nil
+}
+
+// CurrentUser returns the name of the user selected by the last successful
+// InitUser.
+func (fs *FabricSetup) CurrentUser() string {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+	return fs.currentUser
+}
+
+// ChannelClient returns the channel and event clients of the current user.
+func (fs *FabricSetup) ChannelClient() (*channel.Client, *event.Client) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+	return fs.client, fs.event
+}
+
+// connectUser enrolls the user `name` if needed and creates its channel and
+// event clients.
+func (fs *FabricSetup) connectUser(name string, secret ...string) (*channel.Client, *event.Client, error) {
 	var err error
 	if len(secret) == 0 {
 		// err = fs.initUser(name)
@@ -154,29 +221,25 @@ func (fs *FabricSetup) InitUser(name string, secret ...string) error {
 		err = fs.initUserWithSecret(name, secret[0])
 	}
 	if err != nil {
-		return ErrInitClient
+		return nil, nil, ErrInitClient
 	}
 
 	// Channel client is used to query and execute transactions
 	clientContext := fs.sdk.ChannelContext(fs.ChannelID, fabsdk.WithUser(name), fabsdk.WithOrg(fs.OrgName))
-	fs.client, err = channel.New(clientContext)
+	client, err := channel.New(clientContext)
 	if err != nil {
 		Logr.Debugf("channelID %s name %s org %s", fs.ChannelID, name, fs.OrgName)
 		Logr.Errorf("failed to create new channel client for user %s  %v", name, err)
-		return ErrInitClient
+		return nil, nil, ErrInitClient
 	}
 
 	// Creation of the client which will enables access to our channel events
-	fs.event, err = event.New(clientContext)
+	ev, err := event.New(clientContext)
 	if err != nil {
 		Logr.Errorf("failed to create new event client for user %s %v", name, err)
-		return ErrInitClient
+		return nil, nil, ErrInitClient
 	}
-
-	// Everyting is OK.
-	fs.currentUser = name
-	Logr.Debugf("FabricSet.InitUser succeded for %s", fs.currentUser)
-	return nil
+	return client, ev, nil
 }
 
 // -------------------------------------